@@ -2,13 +2,21 @@ package app
 
 import (
 	"encoding/json"
+	"fmt"
 
 	bam "github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 	abci "github.com/tendermint/tendermint/abci/types"
 	cmn "github.com/tendermint/tendermint/libs/common"
 	dbm "github.com/tendermint/tendermint/libs/db"
@@ -16,37 +24,78 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/kava-labs/usdx/blockchain/x/auction"
+	"github.com/kava-labs/usdx/blockchain/x/bep3"
 	"github.com/kava-labs/usdx/blockchain/x/cdp"
+	"github.com/kava-labs/usdx/blockchain/x/committee"
+	"github.com/kava-labs/usdx/blockchain/x/incentive"
 	"github.com/kava-labs/usdx/blockchain/x/liquidator"
 	"github.com/kava-labs/usdx/blockchain/x/pricefeed"
 )
 
 const (
 	appName = "usdx"
+
+	// invCheckPeriod is how often the crisis module runs its registered invariants, in blocks
+	invCheckPeriod = uint(1)
 )
 
+// module account permissions, mirroring the later kava app.go
+var maccPerms = map[string][]string{
+	auth.FeeCollectorName:       nil,
+	distr.ModuleName:            nil,
+	mint.ModuleName:             {supply.Minter},
+	staking.BondedPoolName:      {supply.Burner, supply.Staking},
+	staking.NotBondedPoolName:   {supply.Burner, supply.Staking},
+	bep3.ModuleAccountName:      nil,
+	incentive.ModuleAccountName: {supply.Minter},
+}
+
 // UsdxApp - Extended ABCI application
 type UsdxApp struct {
 	*bam.BaseApp
 	cdc *codec.Codec
 
-	keyMain             *sdk.KVStoreKey
-	keyAccount          *sdk.KVStoreKey
-	keyFeeCollection    *sdk.KVStoreKey
-	keyParams           *sdk.KVStoreKey
-	tkeyParams          *sdk.TransientStoreKey
-	keyPricefeed        *sdk.KVStoreKey
-	keyAuction          *sdk.KVStoreKey
-	keyCdp              *sdk.KVStoreKey
-	keyLiquidator       *sdk.KVStoreKey
-	accountKeeper       auth.AccountKeeper
-	auctionKeeper       auction.Keeper
-	bankKeeper          bank.Keeper
-	cdpKeeper           cdp.Keeper
-	liquidatorKeeper    liquidator.Keeper
-	feeCollectionKeeper auth.FeeCollectionKeeper
-	paramsKeeper        params.Keeper
-	pricefeedKeeper     pricefeed.Keeper
+	// mm orders and runs InitGenesis/BeginBlock/EndBlock for every module that has been migrated onto
+	// the standard module.Manager. pricefeed, cdp, auction and liquidator are explicitly descoped from
+	// this migration: their Keeper/GenesisState/AppModule types live outside this tree (this repo only
+	// imports them), so we cannot add the AppModule wrapper, ExportGenesis or zero-height reset logic
+	// those four would need without inventing their internal schema. They remain wired directly below,
+	// by their existing package-level InitGenesis/BeginBlocker/EndBlocker/Handler/Querier functions.
+	mm *module.Manager
+
+	keyMain          *sdk.KVStoreKey
+	keyAccount       *sdk.KVStoreKey
+	keyParams        *sdk.KVStoreKey
+	tkeyParams       *sdk.TransientStoreKey
+	keyPricefeed     *sdk.KVStoreKey
+	keyAuction       *sdk.KVStoreKey
+	keyCdp           *sdk.KVStoreKey
+	keyLiquidator    *sdk.KVStoreKey
+	keyStaking       *sdk.KVStoreKey
+	tkeyStaking      *sdk.TransientStoreKey
+	keyDistr         *sdk.KVStoreKey
+	keySlashing      *sdk.KVStoreKey
+	keyMint          *sdk.KVStoreKey
+	keySupply        *sdk.KVStoreKey
+	keyCommittee     *sdk.KVStoreKey
+	keyBep3          *sdk.KVStoreKey
+	keyIncentive     *sdk.KVStoreKey
+	accountKeeper    auth.AccountKeeper
+	auctionKeeper    auction.Keeper
+	bankKeeper       bank.Keeper
+	cdpKeeper        cdp.Keeper
+	liquidatorKeeper liquidator.Keeper
+	paramsKeeper     params.Keeper
+	pricefeedKeeper  pricefeed.Keeper
+	stakingKeeper    staking.Keeper
+	distrKeeper      distr.Keeper
+	slashingKeeper   slashing.Keeper
+	mintKeeper       mint.Keeper
+	supplyKeeper     supply.Keeper
+	crisisKeeper     crisis.Keeper
+	committeeKeeper  committee.Keeper
+	bep3Keeper       bep3.Keeper
+	incentiveKeeper  incentive.Keeper
 }
 
 // NewUsdxApp is a constructor function for usdxApp
@@ -63,15 +112,23 @@ func NewUsdxApp(logger log.Logger, db dbm.DB) *UsdxApp {
 		BaseApp: bApp,
 		cdc:     cdc,
 
-		keyMain:          sdk.NewKVStoreKey("main"),
-		keyAccount:       sdk.NewKVStoreKey("acc"),
-		keyFeeCollection: sdk.NewKVStoreKey("fee_collection"),
-		keyParams:        sdk.NewKVStoreKey("params"),
-		tkeyParams:       sdk.NewTransientStoreKey("transient_params"),
-		keyPricefeed:     sdk.NewKVStoreKey("pricefeed"),
-		keyAuction:       sdk.NewKVStoreKey("auction"),
-		keyCdp:           sdk.NewKVStoreKey("cdp"),
-		keyLiquidator:    sdk.NewKVStoreKey("liquidator"),
+		keyMain:       sdk.NewKVStoreKey("main"),
+		keyAccount:    sdk.NewKVStoreKey("acc"),
+		keyParams:     sdk.NewKVStoreKey("params"),
+		tkeyParams:    sdk.NewTransientStoreKey("transient_params"),
+		keyPricefeed:  sdk.NewKVStoreKey("pricefeed"),
+		keyAuction:    sdk.NewKVStoreKey("auction"),
+		keyCdp:        sdk.NewKVStoreKey("cdp"),
+		keyLiquidator: sdk.NewKVStoreKey("liquidator"),
+		keyStaking:    sdk.NewKVStoreKey(staking.StoreKey),
+		tkeyStaking:   sdk.NewTransientStoreKey(staking.TStoreKey),
+		keyDistr:      sdk.NewKVStoreKey(distr.StoreKey),
+		keySlashing:   sdk.NewKVStoreKey(slashing.StoreKey),
+		keyMint:       sdk.NewKVStoreKey(mint.StoreKey),
+		keySupply:     sdk.NewKVStoreKey(supply.StoreKey),
+		keyCommittee:  sdk.NewKVStoreKey(committee.StoreKey),
+		keyBep3:       sdk.NewKVStoreKey(bep3.StoreKey),
+		keyIncentive:  sdk.NewKVStoreKey(incentive.StoreKey),
 	}
 
 	// The ParamsKeeper handles parameter storage for the application
@@ -91,9 +148,6 @@ func NewUsdxApp(logger log.Logger, db dbm.DB) *UsdxApp {
 		bank.DefaultCodespace,
 	)
 
-	// The FeeCollectionKeeper collects transaction fees and renders them to the fee distribution module
-	app.feeCollectionKeeper = auth.NewFeeCollectionKeeper(app.cdc, app.keyFeeCollection)
-
 	// pricefeedKeeper handles postPrice transactions posted by oracles
 	app.pricefeedKeeper = pricefeed.NewKeeper(app.keyPricefeed, app.cdc, pricefeed.DefaultCodespace)
 
@@ -117,41 +171,196 @@ func NewUsdxApp(logger log.Logger, db dbm.DB) *UsdxApp {
 		app.cdpKeeper, // CDP keeper standing in for bank
 	)
 
+	// The SupplyKeeper tracks module account balances and total supply
+	app.supplyKeeper = supply.NewKeeper(
+		app.cdc,
+		app.keySupply,
+		app.accountKeeper,
+		app.bankKeeper,
+		maccPerms,
+	)
+
+	// The StakingKeeper maintains the validator set and bonded/unbonded delegations
+	stakingKeeper := staking.NewKeeper(
+		app.cdc,
+		app.keyStaking,
+		app.tkeyStaking,
+		app.supplyKeeper,
+		app.paramsKeeper.Subspace(staking.DefaultParamspace),
+		staking.DefaultCodespace,
+	)
+
+	// The DistrKeeper pays out staking rewards collected from transaction fees and inflation
+	app.distrKeeper = distr.NewKeeper(
+		app.cdc,
+		app.keyDistr,
+		app.paramsKeeper.Subspace(distr.DefaultParamspace),
+		stakingKeeper,
+		app.supplyKeeper,
+		distr.DefaultCodespace,
+		auth.FeeCollectorName,
+		nil,
+	)
+
+	// The SlashingKeeper penalizes validators that double sign or go offline
+	app.slashingKeeper = slashing.NewKeeper(
+		app.cdc,
+		app.keySlashing,
+		&stakingKeeper,
+		app.paramsKeeper.Subspace(slashing.DefaultParamspace),
+		slashing.DefaultCodespace,
+	)
+
+	// The MintKeeper mints new tokens every block according to the inflation schedule
+	app.mintKeeper = mint.NewKeeper(
+		app.cdc,
+		app.keyMint,
+		app.paramsKeeper.Subspace(mint.DefaultParamspace),
+		&stakingKeeper,
+		app.supplyKeeper,
+		auth.FeeCollectorName,
+	)
+
+	// The CrisisKeeper halts the chain if a registered invariant is broken
+	app.crisisKeeper = crisis.NewKeeper(
+		app.paramsKeeper.Subspace(crisis.DefaultParamspace),
+		invCheckPeriod,
+		app.supplyKeeper,
+		auth.FeeCollectorName,
+	)
+
+	// register the staking hooks, linking slashing and distribution into every staking state transition
+	app.stakingKeeper = *stakingKeeper.SetHooks(
+		staking.NewMultiStakingHooks(app.distrKeeper.Hooks(), app.slashingKeeper.Hooks()),
+	)
+
+	// The CommitteeKeeper lets member and token committees enact parameter changes without a chain upgrade
+	app.committeeKeeper = committee.NewKeeper(
+		app.cdc,
+		app.keyCommittee,
+		app.paramsKeeper.Subspace(committee.DefaultParamspace),
+		app.stakingKeeper,
+	)
+	app.committeeKeeper.RegisterProposalHandler(params.RouterKey, newParamChangeProposalHandler(app.paramsKeeper))
+	app.committeeKeeper.RegisterProposalHandler(committee.RouterKey, newCommitteeProposalHandler(app.committeeKeeper))
+
+	// The Bep3Keeper locks BTC (and other external assets) into hash-timelocked swaps, bridging them in as
+	// collateral the cdp module can open CDPs against
+	app.bep3Keeper = bep3.NewKeeper(
+		app.cdc,
+		app.keyBep3,
+		app.paramsKeeper.Subspace(bep3.DefaultParamspace),
+		app.supplyKeeper,
+	)
+
+	// The IncentiveKeeper mints USDX rewards for CDP owners who maintain collateral during an active
+	// reward period
+	app.incentiveKeeper = incentive.NewKeeper(
+		app.cdc,
+		app.keyIncentive,
+		app.paramsKeeper.Subspace(incentive.DefaultParamspace),
+		app.cdpKeeper,
+		app.supplyKeeper,
+		app.accountKeeper,
+	)
+
 	// The AnteHandler handles signature verification and transaction pre-processing
-	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper, app.feeCollectionKeeper))
+	// fees are deducted straight into the fee_collector supply module account, where distr and mint
+	// both already expect to find them (see auth.FeeCollectorName above)
+	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper, app.supplyKeeper, auth.DefaultSigVerificationGasConsumer))
+
+	// app.mm owns InitGenesis/BeginBlock/EndBlock ordering for every migrated module. auth and bank
+	// don't move any state in Begin/EndBlock so their relative order doesn't matter; staking must run
+	// its EndBlocker (which carries out validator set updates) after slashing and distribution have
+	// had a chance to act on the still-bonded set.
+	app.mm = module.NewManager(
+		auth.NewAppModule(app.accountKeeper),
+		bank.NewAppModule(app.bankKeeper, app.accountKeeper),
+		supply.NewAppModule(app.supplyKeeper, app.accountKeeper),
+		distr.NewAppModule(app.distrKeeper, app.supplyKeeper),
+		slashing.NewAppModule(app.slashingKeeper, app.accountKeeper, app.stakingKeeper),
+		mint.NewAppModule(app.mintKeeper),
+		crisis.NewAppModule(&app.crisisKeeper),
+		staking.NewAppModule(app.stakingKeeper, app.accountKeeper, app.supplyKeeper),
+		committee.NewAppModule(app.committeeKeeper),
+		bep3.NewAppModule(app.bep3Keeper),
+		incentive.NewAppModule(app.incentiveKeeper),
+	)
+
+	app.mm.SetOrderInitGenesis(
+		auth.ModuleName,
+		bank.ModuleName,
+		supply.ModuleName,
+		staking.ModuleName,
+		distr.ModuleName,
+		slashing.ModuleName,
+		mint.ModuleName,
+		crisis.ModuleName,
+		committee.ModuleName,
+		bep3.ModuleName,
+		incentive.ModuleName,
+	)
+
+	app.mm.SetOrderBeginBlockers(
+		mint.ModuleName,
+		distr.ModuleName,
+		slashing.ModuleName,
+		incentive.ModuleName,
+	)
+
+	// CDP liquidation runs in BeginBlock (outside app.mm, below) before auction settlement in
+	// EndBlock, so staking's validator set update is ordered last here to settle on the bonded set
+	// every other EndBlocker saw. incentive's EndBlock is a no-op, so its position doesn't matter.
+	app.mm.SetOrderEndBlockers(
+		incentive.ModuleName,
+		committee.ModuleName,
+		bep3.ModuleName,
+		crisis.ModuleName,
+		staking.ModuleName,
+	)
+
+	// Every module owned by app.mm registers its own routes here; pricefeed, auction, cdp and
+	// liquidator are out of scope for the module.Manager migration (see the mm field's doc comment)
+	// and still register directly below.
+	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
 
-	// The app.Router is the main transaction router where each module registers its routes
 	app.Router().
-		AddRoute("bank", bank.NewHandler(app.bankKeeper)).
 		AddRoute("pricefeed", pricefeed.NewHandler(app.pricefeedKeeper)).
 		AddRoute("auction", auction.NewHandler(app.auctionKeeper)).
 		AddRoute("cdp", cdp.NewHandler(app.cdpKeeper)).
 		AddRoute("liquidator", liquidator.NewHandler(app.liquidatorKeeper))
 
-	// The app.QueryRouter is the main query router where each module registers its routes
 	app.QueryRouter().
-		AddRoute(auth.QuerierRoute, auth.NewQuerier(app.accountKeeper)).
 		AddRoute("pricefeed", pricefeed.NewQuerier(app.pricefeedKeeper)).
 		AddRoute("cdp", cdp.NewQuerier(app.cdpKeeper)).
 		AddRoute("auction", auction.NewQuerier(app.auctionKeeper))
 
 	// The initChainer handles translating the genesis.json file into initial state for the network
 	app.SetInitChainer(app.initChainer)
+	// Set the function to be run at the beginning of every block
+	app.SetBeginBlocker(app.BeginBlocker)
 	// Set the function to be run at the end of every block
-	app.SetEndBlocker(app.endBlocker)
+	app.SetEndBlocker(app.EndBlocker)
 
 	app.MountStores(
 		app.keyMain,
 		app.keyAccount,
-		app.keyFeeCollection,
 		app.keyParams,
 		app.tkeyParams,
 		app.keyPricefeed,
 		app.keyAuction,
 		app.keyCdp,
 		app.keyLiquidator,
+		app.keyStaking,
+		app.tkeyStaking,
+		app.keyDistr,
+		app.keySlashing,
+		app.keyMint,
+		app.keySupply,
+		app.keyCommittee,
+		app.keyBep3,
+		app.keyIncentive,
 	)
-	app.SetEndBlocker(app.EndBlocker)
 	err := app.LoadLatestVersion(app.keyMain)
 	if err != nil {
 		cmn.Exit(err.Error())
@@ -166,6 +375,14 @@ type GenesisState struct {
 	BankData      bank.GenesisState      `json:"bank"`
 	PricefeedData pricefeed.GenesisState `json:"pricfeed"`
 	CdpData       cdp.GenesisState       `json:"cdp"`
+	StakingData   staking.GenesisState   `json:"staking"`
+	DistrData     distr.GenesisState     `json:"distr"`
+	SlashingData  slashing.GenesisState  `json:"slashing"`
+	MintData      mint.GenesisState      `json:"mint"`
+	SupplyData    supply.GenesisState    `json:"supply"`
+	CommitteeData committee.GenesisState `json:"committee"`
+	Bep3Data      bep3.GenesisState      `json:"bep3"`
+	IncentiveData incentive.GenesisState `json:"incentive"`
 	Accounts      []auth.Account         `json:"accounts"` // TODO should this be type []*auth.baseAccount?
 }
 
@@ -183,43 +400,107 @@ func (app *UsdxApp) initChainer(ctx sdk.Context, req abci.RequestInitChain) abci
 		app.accountKeeper.SetAccount(ctx, acc)
 	}
 
-	auth.InitGenesis(ctx, app.accountKeeper, app.feeCollectionKeeper, genesisState.AuthData)
-	bank.InitGenesis(ctx, app.bankKeeper, genesisState.BankData)
+	// pricefeed and cdp are out of scope for the module.Manager migration (see the mm field's doc
+	// comment) and are initialized directly; auction and liquidator genesis state isn't represented in
+	// GenesisState at all, for the same reason
 	pricefeed.InitGenesis(ctx, app.pricefeedKeeper, genesisState.PricefeedData)
 	cdp.InitGenesis(ctx, app.cdpKeeper, genesisState.CdpData)
-	return abci.ResponseInitChain{}
-}
 
-func (app *UsdxApp) endBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
-	auctionTags := auction.EndBlocker(ctx, app.auctionKeeper)
-	pricefeedTags := pricefeed.EndBlocker(ctx, app.pricefeedKeeper)
-	return abci.ResponseEndBlock{
-		Tags: append(auctionTags, pricefeedTags...),
+	moduleGenesisData := map[string]json.RawMessage{
+		auth.ModuleName:      app.cdc.MustMarshalJSON(genesisState.AuthData),
+		bank.ModuleName:      app.cdc.MustMarshalJSON(genesisState.BankData),
+		supply.ModuleName:    app.cdc.MustMarshalJSON(genesisState.SupplyData),
+		staking.ModuleName:   app.cdc.MustMarshalJSON(genesisState.StakingData),
+		distr.ModuleName:     app.cdc.MustMarshalJSON(genesisState.DistrData),
+		slashing.ModuleName:  app.cdc.MustMarshalJSON(genesisState.SlashingData),
+		mint.ModuleName:      app.cdc.MustMarshalJSON(genesisState.MintData),
+		crisis.ModuleName:    app.cdc.MustMarshalJSON(crisis.DefaultGenesisState()),
+		committee.ModuleName: app.cdc.MustMarshalJSON(genesisState.CommitteeData),
+		bep3.ModuleName:      app.cdc.MustMarshalJSON(genesisState.Bep3Data),
+		incentive.ModuleName: app.cdc.MustMarshalJSON(genesisState.IncentiveData),
 	}
-}
+	validatorUpdates := app.mm.InitGenesis(ctx, moduleGenesisData)
 
-// ExportAppStateAndValidators does the things
-// TODO fix this
-func (app *UsdxApp) ExportAppStateAndValidators() (appState json.RawMessage, validators []tmtypes.GenesisValidator, err error) {
-	ctx := app.NewContext(true, abci.Header{})
-	accounts := []*auth.BaseAccount{}
+	return abci.ResponseInitChain{
+		Validators: validatorUpdates,
+	}
+}
 
-	appendAccountsFn := func(acc auth.Account) bool {
-		account := &auth.BaseAccount{
-			Address: acc.GetAddress(),
-			Coins:   acc.GetCoins(),
-		}
+// BeginBlocker mints new tokens, allocates the previous block's collected fees to validators and
+// delegators, and liquidates undercollateralized CDPs ahead of any auctions settling in EndBlock
+func (app *UsdxApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	res := app.mm.BeginBlock(ctx, req)
+	liquidator.BeginBlocker(ctx, app.liquidatorKeeper)
+	return res
+}
 
-		accounts = append(accounts, account)
-		return false
+// ExportAppStateAndValidators exports the current application state and validator set for genesis
+// re-export, e.g. when starting a new chain from the state of an existing one. If forZeroHeight is
+// true, prepForZeroHeightGenesis first pays out distribution rewards, resets the fee pool, and zeroes
+// each validator's unbonding height; unbonding delegations, redelegations and slashing signing info
+// are exported as they are. Addresses in jailWhiteList are exempted from having their validators
+// unjailed during that reset.
+//
+// KNOWN GAP: pricefeed, cdp, auction and liquidator are out of scope for this export (see the mm
+// field's doc comment) because their Keeper/GenesisState types live outside this tree. Their current
+// state (open CDPs, deposits, global debt, posted prices, in-flight auctions, seized collateral) is
+// NOT captured here; PricefeedData/CdpData fall back to each module's zero-value default, and
+// auction/liquidator state has no GenesisState field to even fall back into. Closing this gap requires
+// adding real ExportGenesis methods to those four keepers, which isn't possible without their source.
+func (app *UsdxApp) ExportAppStateAndValidators(
+	forZeroHeight bool, jailWhiteList []string,
+) (appState json.RawMessage, validators []tmtypes.GenesisValidator, err error) {
+	ctx := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+
+	if forZeroHeight {
+		app.prepForZeroHeightGenesis(ctx, jailWhiteList)
 	}
 
-	app.accountKeeper.IterateAccounts(ctx, appendAccountsFn)
+	accounts := []auth.Account{}
+	app.accountKeeper.IterateAccounts(ctx, func(acc auth.Account) bool {
+		accounts = append(accounts, acc)
+		return false
+	})
+
+	managedGenesis := app.mm.ExportGenesis(ctx)
+
+	var authData auth.GenesisState
+	app.cdc.MustUnmarshalJSON(managedGenesis[auth.ModuleName], &authData)
+	var bankData bank.GenesisState
+	app.cdc.MustUnmarshalJSON(managedGenesis[bank.ModuleName], &bankData)
+	var supplyData supply.GenesisState
+	app.cdc.MustUnmarshalJSON(managedGenesis[supply.ModuleName], &supplyData)
+	var stakingData staking.GenesisState
+	app.cdc.MustUnmarshalJSON(managedGenesis[staking.ModuleName], &stakingData)
+	var distrData distr.GenesisState
+	app.cdc.MustUnmarshalJSON(managedGenesis[distr.ModuleName], &distrData)
+	var slashingData slashing.GenesisState
+	app.cdc.MustUnmarshalJSON(managedGenesis[slashing.ModuleName], &slashingData)
+	var mintData mint.GenesisState
+	app.cdc.MustUnmarshalJSON(managedGenesis[mint.ModuleName], &mintData)
+	var committeeData committee.GenesisState
+	app.cdc.MustUnmarshalJSON(managedGenesis[committee.ModuleName], &committeeData)
+	var bep3Data bep3.GenesisState
+	app.cdc.MustUnmarshalJSON(managedGenesis[bep3.ModuleName], &bep3Data)
+	var incentiveData incentive.GenesisState
+	app.cdc.MustUnmarshalJSON(managedGenesis[incentive.ModuleName], &incentiveData)
 
 	genState := GenesisState{
-		Accounts: []auth.Account{}, // TODO fix this, used to be just `accounts`
-		AuthData: auth.DefaultGenesisState(),
-		BankData: bank.DefaultGenesisState(),
+		Accounts:      accounts,
+		AuthData:      authData,
+		BankData:      bankData,
+		SupplyData:    supplyData,
+		StakingData:   stakingData,
+		DistrData:     distrData,
+		SlashingData:  slashingData,
+		MintData:      mintData,
+		CommitteeData: committeeData,
+		Bep3Data:      bep3Data,
+		IncentiveData: incentiveData,
+		// pricefeed and cdp don't implement ExportGenesis and can't gain one here (see the KNOWN GAP
+		// note above); falling back to each module's default genesis silently drops their real state.
+		PricefeedData: pricefeed.DefaultGenesisState(),
+		CdpData:       cdp.DefaultGenesisState(),
 	}
 
 	appState, err = codec.MarshalJSONIndent(app.cdc, genState)
@@ -227,9 +508,51 @@ func (app *UsdxApp) ExportAppStateAndValidators() (appState json.RawMessage, val
 		return nil, nil, err
 	}
 
+	validators = staking.WriteValidators(ctx, app.stakingKeeper)
+
 	return appState, validators, err
 }
 
+// prepForZeroHeightGenesis resets state that only makes sense at the height it was recorded at, so
+// that the exported genesis can be used as height-0 genesis for a new chain. Validators in
+// jailWhiteList are left jailed rather than being unjailed.
+//
+// KNOWN GAP: this only resets staking/distribution state. Auction end-heights, CDP
+// interest-accumulation heights and pricefeed expiry heights also need resetting for a forZeroHeight
+// export to boot cleanly on a fresh chain, but auction/cdp/pricefeed's Keeper types live outside this
+// tree (see the mm field's doc comment on UsdxApp), so there is no in-tree way to reach or reset that
+// state here.
+func (app *UsdxApp) prepForZeroHeightGenesis(ctx sdk.Context, jailWhiteList []string) {
+	whiteListMap := make(map[string]bool)
+	for _, addr := range jailWhiteList {
+		whiteListMap[addr] = true
+	}
+
+	// withdraw all validator and delegator rewards before resetting the distribution fee pool,
+	// otherwise those rewards would simply vanish
+	app.stakingKeeper.IterateValidators(ctx, func(_ int64, val staking.Validator) bool {
+		_, _ = app.distrKeeper.WithdrawValidatorCommission(ctx, val.GetOperator())
+		return false
+	})
+	dels := app.stakingKeeper.GetAllDelegations(ctx)
+	for _, del := range dels {
+		_, _ = app.distrKeeper.WithdrawDelegationRewards(ctx, del.DelegatorAddress, del.ValidatorAddress)
+	}
+	app.distrKeeper.SetFeePool(ctx, distr.InitialFeePool())
+
+	// reset each validator's unbonding height and unjail it unless whitelisted; unbonding delegations,
+	// redelegations and slashing signing info keep the heights they were recorded at
+	app.stakingKeeper.IterateValidators(ctx, func(_ int64, validator staking.Validator) bool {
+		addr := validator.GetOperator()
+		validator.UnbondingHeight = 0
+		if !whiteListMap[addr.String()] {
+			validator.Jailed = false
+		}
+		app.stakingKeeper.SetValidator(ctx, validator)
+		return false
+	})
+}
+
 // MakeCodec generates the necessary codecs for Amino
 func MakeCodec() *codec.Codec {
 	var cdc = codec.New()
@@ -239,6 +562,14 @@ func MakeCodec() *codec.Codec {
 	auction.RegisterCodec(cdc)
 	cdp.RegisterCodec(cdc)
 	liquidator.RegisterCodec(cdc)
+	staking.RegisterCodec(cdc)
+	distr.RegisterCodec(cdc)
+	slashing.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	crisis.RegisterCodec(cdc)
+	committee.RegisterCodec(cdc)
+	bep3.RegisterCodec(cdc)
+	incentive.RegisterCodec(cdc)
 	sdk.RegisterCodec(cdc)
 	codec.RegisterCrypto(cdc)
 	return cdc
@@ -253,13 +584,53 @@ func SetAddressPrefixes() {
 	config.Seal()
 }
 
-// EndBlocker application updates every end block
+// newParamChangeProposalHandler builds a committee.ProposalHandler that applies a
+// params.ParameterChangeProposal once it has been enacted by a committee vote.
+func newParamChangeProposalHandler(k params.Keeper) committee.ProposalHandler {
+	return func(ctx sdk.Context, proposal committee.PubProposal) error {
+		change, ok := proposal.(params.ParameterChangeProposal)
+		if !ok {
+			return fmt.Errorf("unrecognized param change proposal type: %T", proposal)
+		}
+		for _, c := range change.Changes {
+			ss, found := k.GetSubspace(c.Subspace)
+			if !found {
+				return fmt.Errorf("unknown parameter subspace %s", c.Subspace)
+			}
+			if err := ss.Update(ctx, []byte(c.Key), []byte(c.Value)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// newCommitteeProposalHandler builds a committee.ProposalHandler that lets a passed committee proposal
+// add, replace or remove another committee.
+func newCommitteeProposalHandler(k committee.Keeper) committee.ProposalHandler {
+	return func(ctx sdk.Context, proposal committee.PubProposal) error {
+		switch p := proposal.(type) {
+		case committee.CommitteeChangeProposal:
+			k.SetCommittee(ctx, p.Committee)
+			return nil
+		case committee.CommitteeDeleteProposal:
+			k.DeleteCommittee(ctx, p.CommitteeID)
+			return nil
+		default:
+			return fmt.Errorf("unrecognized committee proposal type: %T", proposal)
+		}
+	}
+}
+
+// EndBlocker application updates every end block. CDPs flagged for liquidation in BeginBlock have
+// already moved their collateral into auctions by the time auction's own EndBlocker settles them here.
 func (app *UsdxApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
 	tags := pricefeed.EndBlocker(ctx, app.pricefeedKeeper)
 	auctionTags := auction.EndBlocker(ctx, app.auctionKeeper)
 	tags = append(tags, auctionTags...)
-	return abci.ResponseEndBlock{
-		ValidatorUpdates: []abci.ValidatorUpdate{},
-		Tags:             tags,
-	}
+
+	res := app.mm.EndBlock(ctx, req)
+	res.Tags = append(res.Tags, tags...)
+
+	return res
 }
@@ -0,0 +1,20 @@
+package bep3
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+var moduleCdc = codec.New()
+
+// RegisterCodec registers the bep3 module's concrete types for amino encoding
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgCreateAtomicSwap{}, "bep3/MsgCreateAtomicSwap", nil)
+	cdc.RegisterConcrete(MsgClaimAtomicSwap{}, "bep3/MsgClaimAtomicSwap", nil)
+	cdc.RegisterConcrete(MsgRefundAtomicSwap{}, "bep3/MsgRefundAtomicSwap", nil)
+	cdc.RegisterConcrete(AtomicSwap{}, "bep3/AtomicSwap", nil)
+}
+
+func init() {
+	RegisterCodec(moduleCdc)
+	moduleCdc.Seal()
+}
@@ -0,0 +1,11 @@
+package bep3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker expires atomic swaps whose height span has elapsed, making them refundable
+func EndBlocker(ctx sdk.Context, k Keeper) sdk.Tags {
+	k.ExpireAtomicSwaps(ctx)
+	return sdk.EmptyTags()
+}
@@ -0,0 +1,80 @@
+package bep3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultCodespace is the bep3 module's unique error codespace
+const DefaultCodespace sdk.CodespaceType = ModuleName
+
+// bep3 module error codes
+const (
+	CodeSwapAlreadyExists     sdk.CodeType = 1
+	CodeSwapNotFound          sdk.CodeType = 2
+	CodeSwapNotClaimable      sdk.CodeType = 3
+	CodeSwapNotRefundable     sdk.CodeType = 4
+	CodeInvalidClaimSecret    sdk.CodeType = 5
+	CodeAssetNotSupported     sdk.CodeType = 6
+	CodeAssetNotActive        sdk.CodeType = 7
+	CodeAmountOutsideRange    sdk.CodeType = 8
+	CodeBlockLockOutsideRange sdk.CodeType = 9
+	CodeExceedsSupplyLimit    sdk.CodeType = 10
+	CodeNotDeputy             sdk.CodeType = 11
+)
+
+// ErrSwapAlreadyExists returns an error when a swap with the same ID has already been created
+func ErrSwapAlreadyExists(codespace sdk.CodespaceType, swapID []byte) sdk.Error {
+	return sdk.NewError(codespace, CodeSwapAlreadyExists, "atomic swap %x already exists", swapID)
+}
+
+// ErrSwapNotFound returns an error when no swap exists for the given ID
+func ErrSwapNotFound(codespace sdk.CodespaceType, swapID []byte) sdk.Error {
+	return sdk.NewError(codespace, CodeSwapNotFound, "atomic swap %x does not exist", swapID)
+}
+
+// ErrSwapNotClaimable returns an error when a swap is not in a claimable state
+func ErrSwapNotClaimable(codespace sdk.CodespaceType, swapID []byte) sdk.Error {
+	return sdk.NewError(codespace, CodeSwapNotClaimable, "atomic swap %x is not open", swapID)
+}
+
+// ErrSwapNotRefundable returns an error when a swap has not yet expired
+func ErrSwapNotRefundable(codespace sdk.CodespaceType, swapID []byte) sdk.Error {
+	return sdk.NewError(codespace, CodeSwapNotRefundable, "atomic swap %x has not expired", swapID)
+}
+
+// ErrInvalidClaimSecret returns an error when the claimed random number does not hash to the swap's commitment
+func ErrInvalidClaimSecret(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidClaimSecret, "random number does not match the swap's random number hash")
+}
+
+// ErrAssetNotSupported returns an error when a denom has no configured AssetParam
+func ErrAssetNotSupported(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeAssetNotSupported, "asset %s is not supported", denom)
+}
+
+// ErrAssetNotActive returns an error when a supported asset has been deactivated
+func ErrAssetNotActive(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeAssetNotActive, "asset %s is not currently active", denom)
+}
+
+// ErrAmountOutsideRange returns an error when a swap amount is outside the asset's configured bounds
+func ErrAmountOutsideRange(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeAmountOutsideRange, "swap amount for %s is outside the allowed range", denom)
+}
+
+// ErrBlockLockOutsideRange returns an error when a swap's height span is outside the asset's configured bounds
+func ErrBlockLockOutsideRange(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeBlockLockOutsideRange, "height span for %s is outside the allowed range", denom)
+}
+
+// ErrExceedsSupplyLimit returns an error when locking a swap would push a denom's module account balance
+// past its configured supply limit
+func ErrExceedsSupplyLimit(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeExceedsSupplyLimit, "swap would exceed the supply limit for %s", denom)
+}
+
+// ErrNotDeputy returns an error when an incoming swap is created by anyone other than the asset's
+// configured deputy account
+func ErrNotDeputy(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeNotDeputy, "sender is not the deputy for %s", denom)
+}
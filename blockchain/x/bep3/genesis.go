@@ -0,0 +1,42 @@
+package bep3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState is the state that must be provided at genesis for the bep3 module
+type GenesisState struct {
+	Params      Params       `json:"params" yaml:"params"`
+	AtomicSwaps []AtomicSwap `json:"atomic_swaps" yaml:"atomic_swaps"`
+}
+
+// DefaultGenesisState returns the default genesis state for the bep3 module, with no assets configured
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params:      DefaultParams(),
+		AtomicSwaps: []AtomicSwap{},
+	}
+}
+
+// InitGenesis initializes the bep3 module's state from a provided genesis state
+func InitGenesis(ctx sdk.Context, k Keeper, gs GenesisState) {
+	k.SetParams(ctx, gs.Params)
+	for _, swap := range gs.AtomicSwaps {
+		swapID := GetSwapID(swap.RandomNumberHash, swap.Sender, swap.SenderOtherChain)
+		k.SetAtomicSwap(ctx, swap, swapID)
+	}
+}
+
+// ExportGenesis extracts the bep3 module's state for genesis export
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	var swaps []AtomicSwap
+	k.IterateAtomicSwaps(ctx, func(swap AtomicSwap) bool {
+		swaps = append(swaps, swap)
+		return false
+	})
+
+	return GenesisState{
+		Params:      k.GetParams(ctx),
+		AtomicSwaps: swaps,
+	}
+}
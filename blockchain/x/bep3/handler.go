@@ -0,0 +1,60 @@
+package bep3
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewHandler creates an sdk.Handler for the bep3 module's messages
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case MsgCreateAtomicSwap:
+			return handleMsgCreateAtomicSwap(ctx, k, msg)
+		case MsgClaimAtomicSwap:
+			return handleMsgClaimAtomicSwap(ctx, k, msg)
+		case MsgRefundAtomicSwap:
+			return handleMsgRefundAtomicSwap(ctx, k, msg)
+		default:
+			return sdk.ErrUnknownRequest("unrecognized bep3 message type").Result()
+		}
+	}
+}
+
+func handleMsgCreateAtomicSwap(ctx sdk.Context, k Keeper, msg MsgCreateAtomicSwap) sdk.Result {
+	swapID, err := k.CreateAtomicSwap(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			"action", "create_atomic_swap",
+			"swap_id", fmt.Sprintf("%x", swapID),
+		),
+	}
+}
+
+func handleMsgClaimAtomicSwap(ctx sdk.Context, k Keeper, msg MsgClaimAtomicSwap) sdk.Result {
+	if err := k.ClaimAtomicSwap(ctx, msg.SwapID, msg.RandomNumber); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			"action", "claim_atomic_swap",
+			"swap_id", fmt.Sprintf("%x", msg.SwapID),
+		),
+	}
+}
+
+func handleMsgRefundAtomicSwap(ctx sdk.Context, k Keeper, msg MsgRefundAtomicSwap) sdk.Result {
+	if err := k.RefundAtomicSwap(ctx, msg.SwapID); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			"action", "refund_atomic_swap",
+			"swap_id", fmt.Sprintf("%x", msg.SwapID),
+		),
+	}
+}
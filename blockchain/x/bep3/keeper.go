@@ -0,0 +1,230 @@
+package bep3
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
+// SupplyKeeper defines the supply functionality the bep3 module depends on, to move coins into and out of
+// its module account
+type SupplyKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) sdk.Error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+	GetModuleAccount(ctx sdk.Context, moduleName string) supply.ModuleAccountI
+}
+
+// Keeper stores atomic swaps and enforces their hash-timelock semantics
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        *codec.Codec
+	paramstore params.Subspace
+	supply     SupplyKeeper
+}
+
+// NewKeeper creates a new bep3 Keeper
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramstore params.Subspace, supplyKeeper SupplyKeeper) Keeper {
+	return Keeper{
+		storeKey:   key,
+		cdc:        cdc,
+		paramstore: paramstore.WithKeyTable(ParamKeyTable()),
+		supply:     supplyKeeper,
+	}
+}
+
+// GetParams returns the bep3 module's parameters
+func (k Keeper) GetParams(ctx sdk.Context) Params {
+	var p Params
+	k.paramstore.GetParamSet(ctx, &p)
+	return p
+}
+
+// SetParams sets the bep3 module's parameters
+func (k Keeper) SetParams(ctx sdk.Context, p Params) {
+	k.paramstore.SetParamSet(ctx, &p)
+}
+
+// GetAssetParam looks up the configured limits for a denom, erroring if the denom is unknown or inactive
+func (k Keeper) GetAssetParam(ctx sdk.Context, denom string) (AssetParam, sdk.Error) {
+	ap, found := k.GetParams(ctx).GetAssetParam(denom)
+	if !found {
+		return AssetParam{}, ErrAssetNotSupported(DefaultCodespace, denom)
+	}
+	if !ap.Active {
+		return AssetParam{}, ErrAssetNotActive(DefaultCodespace, denom)
+	}
+	return ap, nil
+}
+
+// GetAtomicSwap fetches a swap by ID
+func (k Keeper) GetAtomicSwap(ctx sdk.Context, swapID []byte) (AtomicSwap, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(append(SwapKeyPrefix, swapID...))
+	if bz == nil {
+		return AtomicSwap{}, false
+	}
+	var swap AtomicSwap
+	k.cdc.MustUnmarshalBinaryBare(bz, &swap)
+	return swap, true
+}
+
+// SetAtomicSwap stores a swap and indexes it by expire height
+func (k Keeper) SetAtomicSwap(ctx sdk.Context, swap AtomicSwap, swapID []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(append(SwapKeyPrefix, swapID...), k.cdc.MustMarshalBinaryBare(swap))
+	store.Set(GetSwapExpireHeightKey(swap.ExpireHeight, swapID), swapID)
+}
+
+func (k Keeper) deleteExpireHeightIndex(ctx sdk.Context, swap AtomicSwap, swapID []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetSwapExpireHeightKey(swap.ExpireHeight, swapID))
+}
+
+// CreateAtomicSwap validates and opens a new atomic swap, locking the sender's coins into the module account
+func (k Keeper) CreateAtomicSwap(ctx sdk.Context, msg MsgCreateAtomicSwap) ([]byte, sdk.Error) {
+	direction := Incoming
+	if !msg.CrossChain {
+		direction = Outgoing
+	}
+
+	for _, coin := range msg.Amount {
+		ap, err := k.GetAssetParam(ctx, coin.Denom)
+		if err != nil {
+			return nil, err
+		}
+		if coin.Amount.LT(ap.MinSwapAmount) || coin.Amount.GT(ap.MaxSwapAmount) {
+			return nil, ErrAmountOutsideRange(DefaultCodespace, coin.Denom)
+		}
+		if msg.HeightSpan < ap.MinBlockLock || msg.HeightSpan > ap.MaxBlockLock {
+			return nil, ErrBlockLockOutsideRange(DefaultCodespace, coin.Denom)
+		}
+		// an Incoming swap represents the deputy relaying a deposit that already happened on the
+		// other chain, so only the configured deputy account may create one; Outgoing swaps lock an
+		// ordinary user's coins bound for the deputy and carry no such restriction
+		if direction == Incoming && !msg.Sender.Equals(ap.DeputyAddress) {
+			return nil, ErrNotDeputy(DefaultCodespace, coin.Denom)
+		}
+		currentSupply := k.supply.GetModuleAccount(ctx, ModuleAccountName).GetCoins().AmountOf(coin.Denom)
+		if currentSupply.Add(coin.Amount).GT(ap.SupplyLimit) {
+			return nil, ErrExceedsSupplyLimit(DefaultCodespace, coin.Denom)
+		}
+	}
+
+	swapID := GetSwapID(msg.RandomNumberHash, msg.Sender, msg.SenderOtherChain)
+	if _, found := k.GetAtomicSwap(ctx, swapID); found {
+		return nil, ErrSwapAlreadyExists(DefaultCodespace, swapID)
+	}
+
+	if err := k.supply.SendCoinsFromAccountToModule(ctx, msg.Sender, ModuleAccountName, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	swap := AtomicSwap{
+		RandomNumberHash:    msg.RandomNumberHash,
+		Timestamp:           msg.Timestamp,
+		Sender:              msg.Sender,
+		Recipient:           msg.Recipient,
+		SenderOtherChain:    msg.SenderOtherChain,
+		RecipientOtherChain: msg.RecipientOtherChain,
+		Amount:              msg.Amount,
+		ExpectedIncome:      msg.ExpectedIncome,
+		HeightSpan:          msg.HeightSpan,
+		CrossChain:          msg.CrossChain,
+		Direction:           direction,
+		Status:              Open,
+		ExpireHeight:        ctx.BlockHeight() + msg.HeightSpan,
+	}
+	k.SetAtomicSwap(ctx, swap, swapID)
+
+	return swapID, nil
+}
+
+// ClaimAtomicSwap verifies randomNumber hashes to the swap's commitment and pays the recipient
+func (k Keeper) ClaimAtomicSwap(ctx sdk.Context, swapID, randomNumber []byte) sdk.Error {
+	swap, found := k.GetAtomicSwap(ctx, swapID)
+	if !found {
+		return ErrSwapNotFound(DefaultCodespace, swapID)
+	}
+	if swap.Status != Open {
+		return ErrSwapNotClaimable(DefaultCodespace, swapID)
+	}
+
+	computedHash := CalculateRandomHash(randomNumber, swap.Timestamp)
+	if !bytes.Equal(computedHash[:], swap.RandomNumberHash[:]) {
+		return ErrInvalidClaimSecret(DefaultCodespace)
+	}
+
+	if err := k.supply.SendCoinsFromModuleToAccount(ctx, ModuleAccountName, swap.Recipient, swap.Amount); err != nil {
+		return err
+	}
+
+	k.deleteExpireHeightIndex(ctx, swap, swapID)
+	swap.Status = Completed
+	store := ctx.KVStore(k.storeKey)
+	store.Set(append(SwapKeyPrefix, swapID...), k.cdc.MustMarshalBinaryBare(swap))
+	return nil
+}
+
+// RefundAtomicSwap returns an expired, unclaimed swap's coins back to its sender
+func (k Keeper) RefundAtomicSwap(ctx sdk.Context, swapID []byte) sdk.Error {
+	swap, found := k.GetAtomicSwap(ctx, swapID)
+	if !found {
+		return ErrSwapNotFound(DefaultCodespace, swapID)
+	}
+	if swap.Status != Expired {
+		return ErrSwapNotRefundable(DefaultCodespace, swapID)
+	}
+
+	if err := k.supply.SendCoinsFromModuleToAccount(ctx, ModuleAccountName, swap.Sender, swap.Amount); err != nil {
+		return err
+	}
+
+	k.deleteExpireHeightIndex(ctx, swap, swapID)
+	swap.Status = Refunded
+	store := ctx.KVStore(k.storeKey)
+	store.Set(append(SwapKeyPrefix, swapID...), k.cdc.MustMarshalBinaryBare(swap))
+	return nil
+}
+
+// IterateAtomicSwaps iterates over every stored swap
+func (k Keeper) IterateAtomicSwaps(ctx sdk.Context, cb func(AtomicSwap) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, SwapKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var swap AtomicSwap
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &swap)
+		if cb(swap) {
+			break
+		}
+	}
+}
+
+// ExpireAtomicSwaps flags every Open swap whose ExpireHeight has passed as Expired, ready to be refunded.
+// Called from the EndBlocker.
+func (k Keeper) ExpireAtomicSwaps(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	// the index is keyed by (height, swapID) so this walks expired swaps in height order, from genesis
+	// height up to and including the current block
+	endKey := GetSwapExpireHeightKey(ctx.BlockHeight()+1, []byte{})
+	iterator := store.Iterator(SwapExpireHeightPrefix, endKey)
+	defer iterator.Close()
+
+	var swapIDs [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		swapIDs = append(swapIDs, iterator.Value())
+	}
+
+	for _, swapID := range swapIDs {
+		swap, found := k.GetAtomicSwap(ctx, swapID)
+		if !found || swap.Status != Open {
+			continue
+		}
+		swap.Status = Expired
+		store.Set(append(SwapKeyPrefix, swapID...), k.cdc.MustMarshalBinaryBare(swap))
+		k.deleteExpireHeightIndex(ctx, swap, swapID)
+	}
+}
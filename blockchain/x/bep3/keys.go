@@ -0,0 +1,37 @@
+package bep3
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "bep3"
+
+	// StoreKey is the store key string for the bep3 module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the bep3 module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the bep3 module
+	QuerierRoute = ModuleName
+
+	// DefaultParamspace is the default name for the bep3 param subspace
+	DefaultParamspace = ModuleName
+
+	// ModuleAccountName is the name of the module account that holds swapped-in collateral
+	ModuleAccountName = ModuleName
+)
+
+var (
+	// SwapKeyPrefix is the prefix atomic swaps are stored under, keyed by SwapID
+	SwapKeyPrefix = []byte{0x01}
+	// SwapExpireHeightPrefix indexes swap IDs by their expire height, for cheap EndBlocker expiry
+	SwapExpireHeightPrefix = []byte{0x02}
+)
+
+// GetSwapExpireHeightKey builds the index key for a swap's expiration, (height, swapID)
+func GetSwapExpireHeightKey(expireHeight int64, swapID []byte) []byte {
+	key := make([]byte, 8)
+	for i := 0; i < 8; i++ {
+		key[7-i] = byte(expireHeight >> (8 * uint(i)))
+	}
+	return append(append(SwapExpireHeightPrefix, key...), swapID...)
+}
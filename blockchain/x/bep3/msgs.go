@@ -0,0 +1,123 @@
+package bep3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgCreateAtomicSwap locks Amount from Sender into the module account, creating a new Open swap that
+// Recipient can claim by revealing the random number hashed into RandomNumberHash.
+type MsgCreateAtomicSwap struct {
+	Sender              sdk.AccAddress `json:"sender" yaml:"sender"`
+	Recipient           sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	RecipientOtherChain string         `json:"recipient_other_chain" yaml:"recipient_other_chain"`
+	SenderOtherChain    string         `json:"sender_other_chain" yaml:"sender_other_chain"`
+	RandomNumberHash    [32]byte       `json:"random_number_hash" yaml:"random_number_hash"`
+	Timestamp           int64          `json:"timestamp" yaml:"timestamp"`
+	Amount              sdk.Coins      `json:"amount" yaml:"amount"`
+	ExpectedIncome      string         `json:"expected_income" yaml:"expected_income"`
+	HeightSpan          int64          `json:"height_span" yaml:"height_span"`
+	CrossChain          bool           `json:"cross_chain" yaml:"cross_chain"`
+}
+
+// Route implements sdk.Msg
+func (msg MsgCreateAtomicSwap) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgCreateAtomicSwap) Type() string { return "create_atomic_swap" }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgCreateAtomicSwap) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress("sender address cannot be empty")
+	}
+	if msg.Recipient.Empty() {
+		return sdk.ErrInvalidAddress("recipient address cannot be empty")
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsAllPositive() {
+		return sdk.ErrInvalidCoins("swap amount must be positive")
+	}
+	if msg.HeightSpan <= 0 {
+		return sdk.ErrUnknownRequest("height span must be positive")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgCreateAtomicSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgCreateAtomicSwap) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgClaimAtomicSwap claims an open swap by revealing the random number committed to in its RandomNumberHash.
+type MsgClaimAtomicSwap struct {
+	From         sdk.AccAddress `json:"from" yaml:"from"`
+	SwapID       []byte         `json:"swap_id" yaml:"swap_id"`
+	RandomNumber []byte         `json:"random_number" yaml:"random_number"`
+}
+
+// Route implements sdk.Msg
+func (msg MsgClaimAtomicSwap) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgClaimAtomicSwap) Type() string { return "claim_atomic_swap" }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgClaimAtomicSwap) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return sdk.ErrInvalidAddress("from address cannot be empty")
+	}
+	if len(msg.SwapID) == 0 {
+		return sdk.ErrUnknownRequest("swap id cannot be empty")
+	}
+	if len(msg.RandomNumber) == 0 {
+		return sdk.ErrUnknownRequest("random number cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgClaimAtomicSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgClaimAtomicSwap) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+// MsgRefundAtomicSwap returns an expired swap's locked coins back to its original sender.
+type MsgRefundAtomicSwap struct {
+	From   sdk.AccAddress `json:"from" yaml:"from"`
+	SwapID []byte         `json:"swap_id" yaml:"swap_id"`
+}
+
+// Route implements sdk.Msg
+func (msg MsgRefundAtomicSwap) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgRefundAtomicSwap) Type() string { return "refund_atomic_swap" }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRefundAtomicSwap) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return sdk.ErrInvalidAddress("from address cannot be empty")
+	}
+	if len(msg.SwapID) == 0 {
+		return sdk.ErrUnknownRequest("swap id cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgRefundAtomicSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRefundAtomicSwap) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
@@ -0,0 +1,70 @@
+package bep3
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// bep3 module parameter store keys
+var (
+	KeyAssetParams = []byte("AssetParams")
+)
+
+// ParamKeyTable returns the param key table for the bep3 module
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Params governs the per-asset limits atomic swaps must respect
+type Params struct {
+	AssetParams []AssetParam `json:"asset_params" yaml:"asset_params"`
+}
+
+// NewParams instantiates a new Params
+func NewParams(assetParams []AssetParam) Params {
+	return Params{AssetParams: assetParams}
+}
+
+// DefaultParams returns default bep3 params, with no assets enabled
+func DefaultParams() Params {
+	return NewParams([]AssetParam{})
+}
+
+// ParamSetPairs implements the ParamSet interface
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyAssetParams, &p.AssetParams, validateAssetParams),
+	}
+}
+
+func validateAssetParams(i interface{}) error {
+	assetParams, ok := i.([]AssetParam)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seenDenoms := map[string]bool{}
+	for _, ap := range assetParams {
+		if seenDenoms[ap.Denom] {
+			return fmt.Errorf("duplicate asset denom: %s", ap.Denom)
+		}
+		seenDenoms[ap.Denom] = true
+		if ap.MinBlockLock > ap.MaxBlockLock {
+			return fmt.Errorf("asset %s: min block lock %d exceeds max block lock %d", ap.Denom, ap.MinBlockLock, ap.MaxBlockLock)
+		}
+		if ap.MinSwapAmount.GT(ap.MaxSwapAmount) {
+			return fmt.Errorf("asset %s: min swap amount exceeds max swap amount", ap.Denom)
+		}
+	}
+	return nil
+}
+
+// GetAssetParam looks up the params for a given denom
+func (p Params) GetAssetParam(denom string) (AssetParam, bool) {
+	for _, ap := range p.AssetParams {
+		if ap.Denom == denom {
+			return ap, true
+		}
+	}
+	return AssetParam{}, false
+}
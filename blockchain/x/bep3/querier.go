@@ -0,0 +1,82 @@
+package bep3
+
+import (
+	"encoding/hex"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// bep3 module querier route endpoints
+const (
+	QuerySwap          = "swap"
+	QuerySwapsByStatus = "swaps"
+)
+
+// NewQuerier creates a querier for the bep3 module
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QuerySwap:
+			return querySwap(ctx, path[1:], k)
+		case QuerySwapsByStatus:
+			return querySwaps(ctx, path[1:], k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown bep3 query endpoint")
+		}
+	}
+}
+
+func querySwap(ctx sdk.Context, args []string, k Keeper) ([]byte, sdk.Error) {
+	if len(args) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing swap id")
+	}
+	swapID, err := hex.DecodeString(args[0])
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest("invalid swap id")
+	}
+	swap, found := k.GetAtomicSwap(ctx, swapID)
+	if !found {
+		return nil, ErrSwapNotFound(DefaultCodespace, swapID)
+	}
+	bz, merr := codec.MarshalJSONIndent(k.cdc, swap)
+	if merr != nil {
+		return nil, sdk.ErrInternal(merr.Error())
+	}
+	return bz, nil
+}
+
+// querySwaps filters by status and/or an address involved in the swap, eg "swaps/open" or "swaps/open/<addr>"
+func querySwaps(ctx sdk.Context, args []string, k Keeper) ([]byte, sdk.Error) {
+	var statusFilter string
+	var addrFilter sdk.AccAddress
+	if len(args) > 0 {
+		statusFilter = args[0]
+	}
+	if len(args) > 1 {
+		addr, err := sdk.AccAddressFromBech32(args[1])
+		if err != nil {
+			return nil, sdk.ErrInvalidAddress(args[1])
+		}
+		addrFilter = addr
+	}
+
+	var swaps []AtomicSwap
+	k.IterateAtomicSwaps(ctx, func(swap AtomicSwap) bool {
+		if statusFilter != "" && swap.Status.String() != statusFilter {
+			return false
+		}
+		if !addrFilter.Empty() && !swap.Sender.Equals(addrFilter) && !swap.Recipient.Equals(addrFilter) {
+			return false
+		}
+		swaps = append(swaps, swap)
+		return false
+	})
+
+	bz, merr := codec.MarshalJSONIndent(k.cdc, swaps)
+	if merr != nil {
+		return nil, sdk.ErrInternal(merr.Error())
+	}
+	return bz, nil
+}
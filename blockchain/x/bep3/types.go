@@ -0,0 +1,96 @@
+package bep3
+
+import (
+	"crypto/sha256"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SwapStatus is the lifecycle state of an AtomicSwap
+type SwapStatus byte
+
+const (
+	// Open means the swap has been created and is waiting to be claimed or to expire
+	Open SwapStatus = iota
+	// Completed means the swap was claimed with the correct random number
+	Completed
+	// Expired means the swap passed its expire height without being claimed
+	Expired
+	// Refunded means an expired swap's coins were returned to the sender
+	Refunded
+)
+
+// String implements fmt.Stringer
+func (s SwapStatus) String() string {
+	switch s {
+	case Open:
+		return "open"
+	case Completed:
+		return "completed"
+	case Expired:
+		return "expired"
+	case Refunded:
+		return "refunded"
+	default:
+		return "unknown"
+	}
+}
+
+// SwapDirection is which way a cross-chain swap is moving relative to this chain
+type SwapDirection byte
+
+const (
+	// Incoming means coins from another chain are being swapped in as collateral on this chain
+	Incoming SwapDirection = iota
+	// Outgoing means coins on this chain are being swapped out to another chain
+	Outgoing
+)
+
+// AtomicSwap is a hash-timelocked transfer of coins, claimable by anyone who knows the preimage of
+// RandomNumberHash before ExpireHeight, or refundable by the sender afterwards.
+type AtomicSwap struct {
+	RandomNumberHash    [32]byte       `json:"random_number_hash" yaml:"random_number_hash"`
+	Timestamp           int64          `json:"timestamp" yaml:"timestamp"`
+	Sender              sdk.AccAddress `json:"sender" yaml:"sender"`
+	Recipient           sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	SenderOtherChain    string         `json:"sender_other_chain" yaml:"sender_other_chain"`
+	RecipientOtherChain string         `json:"recipient_other_chain" yaml:"recipient_other_chain"`
+	Amount              sdk.Coins      `json:"amount" yaml:"amount"`
+	ExpectedIncome      string         `json:"expected_income" yaml:"expected_income"`
+	HeightSpan          int64          `json:"height_span" yaml:"height_span"`
+	CrossChain          bool           `json:"cross_chain" yaml:"cross_chain"`
+	Direction           SwapDirection  `json:"direction" yaml:"direction"`
+	Status              SwapStatus     `json:"status" yaml:"status"`
+	ExpireHeight        int64          `json:"expire_height" yaml:"expire_height"`
+}
+
+// GetSwapID computes the unique ID of a swap, sha256(randomNumberHash || sender || senderOtherChain)
+func GetSwapID(randomNumberHash [32]byte, sender sdk.AccAddress, senderOtherChain string) []byte {
+	h := sha256.New()
+	h.Write(randomNumberHash[:])
+	h.Write(sender.Bytes())
+	h.Write([]byte(senderOtherChain))
+	return h.Sum(nil)
+}
+
+// CalculateRandomHash computes the hash a claimant must reveal the preimage of to claim a swap
+func CalculateRandomHash(randomNumber []byte, timestamp int64) [32]byte {
+	timeBz := make([]byte, 8)
+	for i := 0; i < 8; i++ {
+		timeBz[7-i] = byte(timestamp >> (8 * uint(i)))
+	}
+	return sha256.Sum256(append(timeBz, randomNumber...))
+}
+
+// AssetParam defines the per-asset limits that bound atomic swaps involving a given denom
+type AssetParam struct {
+	Denom         string         `json:"denom" yaml:"denom"`
+	CoinID        int            `json:"coin_id" yaml:"coin_id"`
+	SupplyLimit   sdk.Int        `json:"supply_limit" yaml:"supply_limit"`
+	Active        bool           `json:"active" yaml:"active"`
+	MinSwapAmount sdk.Int        `json:"min_swap_amount" yaml:"min_swap_amount"`
+	MaxSwapAmount sdk.Int        `json:"max_swap_amount" yaml:"max_swap_amount"`
+	MinBlockLock  int64          `json:"min_block_lock" yaml:"min_block_lock"`
+	MaxBlockLock  int64          `json:"max_block_lock" yaml:"max_block_lock"`
+	DeputyAddress sdk.AccAddress `json:"deputy_address" yaml:"deputy_address"`
+}
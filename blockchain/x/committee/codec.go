@@ -0,0 +1,32 @@
+package committee
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// moduleCdc is the committee module's own codec, used for its genesis JSON and message sign bytes
+var moduleCdc = codec.New()
+
+// RegisterCodec registers the committee module's interfaces and concrete types for amino encoding
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterInterface((*PubProposal)(nil), nil)
+	cdc.RegisterInterface((*Committee)(nil), nil)
+	cdc.RegisterInterface((*Permission)(nil), nil)
+
+	cdc.RegisterConcrete(MemberCommittee{}, "committee/MemberCommittee", nil)
+	cdc.RegisterConcrete(TokenCommittee{}, "committee/TokenCommittee", nil)
+
+	cdc.RegisterConcrete(ParamsChangePermission{}, "committee/ParamsChangePermission", nil)
+	cdc.RegisterConcrete(GodPermission{}, "committee/GodPermission", nil)
+
+	cdc.RegisterConcrete(CommitteeChangeProposal{}, "committee/CommitteeChangeProposal", nil)
+	cdc.RegisterConcrete(CommitteeDeleteProposal{}, "committee/CommitteeDeleteProposal", nil)
+
+	cdc.RegisterConcrete(MsgSubmitProposal{}, "committee/MsgSubmitProposal", nil)
+	cdc.RegisterConcrete(MsgVote{}, "committee/MsgVote", nil)
+}
+
+func init() {
+	RegisterCodec(moduleCdc)
+	moduleCdc.Seal()
+}
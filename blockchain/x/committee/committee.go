@@ -0,0 +1,123 @@
+package committee
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Committee is implemented by MemberCommittee and TokenCommittee. It defines how a set of votes on a proposal
+// is tallied into a pass/fail result.
+type Committee interface {
+	GetID() uint64
+	GetDescription() string
+	// HasPermission reports whether this committee is allowed to submit the given proposal
+	HasPermission(proposal PubProposal) bool
+	// Tally computes whether a proposal has enough support to be enacted, given the votes cast so far and,
+	// for token committees, each voter's bonded stake.
+	Tally(votes []Vote, bondedTokens func(sdk.AccAddress) sdk.Int, totalBonded sdk.Int) bool
+	// VoteIsAllowed rejects vote types a committee kind doesn't support, eg NoWithVeto on a MemberCommittee
+	VoteIsAllowed(voteType VoteType) bool
+}
+
+// BaseCommittee holds the fields and behaviour common to every committee kind.
+type BaseCommittee struct {
+	ID          uint64           `json:"id" yaml:"id"`
+	Description string           `json:"description" yaml:"description"`
+	Members     []sdk.AccAddress `json:"members" yaml:"members"`
+	Permissions []Permission     `json:"permissions" yaml:"permissions"`
+}
+
+// GetID implements Committee
+func (c BaseCommittee) GetID() uint64 { return c.ID }
+
+// GetDescription implements Committee
+func (c BaseCommittee) GetDescription() string { return c.Description }
+
+// HasPermission implements Committee
+func (c BaseCommittee) HasPermission(proposal PubProposal) bool {
+	for _, p := range c.Permissions {
+		if p.Allows(proposal) {
+			return true
+		}
+	}
+	return false
+}
+
+// MemberCommittee is a fixed list of members who vote Yes/No/Abstain; a proposal passes once enough of the
+// membership has voted yes.
+type MemberCommittee struct {
+	BaseCommittee `json:"base_committee" yaml:"base_committee"`
+	VoteThreshold sdk.Dec `json:"vote_threshold" yaml:"vote_threshold"`
+}
+
+// VoteIsAllowed implements Committee. Member committees have no concept of veto.
+func (c MemberCommittee) VoteIsAllowed(voteType VoteType) bool {
+	return voteType != VoteTypeNoWithVeto
+}
+
+// Tally implements Committee. A member committee enacts once yes_votes / len(members) >= vote_threshold.
+// Votes cast by addresses that are not members are not counted.
+func (c MemberCommittee) Tally(votes []Vote, _ func(sdk.AccAddress) sdk.Int, _ sdk.Int) bool {
+	if len(c.Members) == 0 {
+		return false
+	}
+	yesVotes := sdk.ZeroDec()
+	for _, v := range votes {
+		if v.VoteType != VoteTypeYes {
+			continue
+		}
+		for _, m := range c.Members {
+			if m.Equals(v.Voter) {
+				yesVotes = yesVotes.Add(sdk.OneDec())
+				break
+			}
+		}
+	}
+	support := yesVotes.QuoInt64(int64(len(c.Members)))
+	return support.GTE(c.VoteThreshold)
+}
+
+// TokenCommittee allows any bonded staker to vote, weighted by bonded stake, with a quorum and veto threshold.
+type TokenCommittee struct {
+	BaseCommittee `json:"base_committee" yaml:"base_committee"`
+	Quorum        sdk.Dec `json:"quorum" yaml:"quorum"`
+	VoteThreshold sdk.Dec `json:"vote_threshold" yaml:"vote_threshold"`
+	VetoThreshold sdk.Dec `json:"veto_threshold" yaml:"veto_threshold"`
+}
+
+// VoteIsAllowed implements Committee. Any of the four vote types may be cast.
+func (c TokenCommittee) VoteIsAllowed(VoteType) bool { return true }
+
+// Tally implements Committee using bonded stake at the proposal's deadline as voting weight.
+func (c TokenCommittee) Tally(votes []Vote, bondedTokens func(sdk.AccAddress) sdk.Int, totalBonded sdk.Int) bool {
+	if totalBonded.IsZero() {
+		return false
+	}
+
+	yes, no, veto, total := sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()
+	for _, v := range votes {
+		weight := sdk.NewDecFromInt(bondedTokens(v.Voter))
+		total = total.Add(weight)
+		switch v.VoteType {
+		case VoteTypeYes:
+			yes = yes.Add(weight)
+		case VoteTypeNo:
+			no = no.Add(weight)
+		case VoteTypeNoWithVeto:
+			veto = veto.Add(weight)
+		}
+	}
+
+	quorumReached := total.Quo(sdk.NewDecFromInt(totalBonded)).GTE(c.Quorum)
+	if !quorumReached {
+		return false
+	}
+	if total.IsPositive() && veto.Quo(total).GT(c.VetoThreshold) {
+		return false
+	}
+
+	decisive := yes.Add(no).Add(veto)
+	if !decisive.IsPositive() {
+		return false
+	}
+	return yes.Quo(decisive).GTE(c.VoteThreshold)
+}
@@ -0,0 +1,46 @@
+package committee
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultCodespace is the committee module's unique error codespace
+const DefaultCodespace sdk.CodespaceType = ModuleName
+
+// committee module error codes
+const (
+	CodeUnknownCommittee   sdk.CodeType = 1
+	CodeUnknownProposal    sdk.CodeType = 2
+	CodeProposalExpired    sdk.CodeType = 3
+	CodeInvalidVoteType    sdk.CodeType = 4
+	CodeNotAuthorized      sdk.CodeType = 5
+	CodeInvalidPubProposal sdk.CodeType = 6
+)
+
+// ErrUnknownCommittee returns an error when a committee with the given ID does not exist
+func ErrUnknownCommittee(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownCommittee, "committee with ID %d does not exist", id)
+}
+
+// ErrUnknownProposal returns an error when a proposal with the given ID does not exist
+func ErrUnknownProposal(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownProposal, "proposal with ID %d does not exist", id)
+}
+
+// ErrProposalExpired returns an error when a vote is cast on a proposal past its deadline
+func ErrProposalExpired(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeProposalExpired, "proposal %d has passed its voting deadline", id)
+}
+
+// ErrInvalidVoteType returns an error when a vote type is not allowed by a committee
+func ErrInvalidVoteType(codespace sdk.CodespaceType, voteType VoteType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVoteType, "vote type %s is not allowed by this committee", voteType)
+}
+
+// ErrNotAuthorized returns an error when a committee does not have permission to submit a proposal
+func ErrNotAuthorized(codespace sdk.CodespaceType, committeeID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeNotAuthorized, "committee %d is not authorized to submit this proposal", committeeID)
+}
+
+// ErrNoCommitteeFound is returned when a CommitteeChangeProposal is submitted with no committee attached
+var ErrNoCommitteeFound = sdk.NewError(DefaultCodespace, CodeInvalidPubProposal, "proposal has no committee attached")
@@ -0,0 +1,77 @@
+package committee
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState is the state that must be provided at genesis for the committee module
+type GenesisState struct {
+	NextProposalID uint64      `json:"next_proposal_id" yaml:"next_proposal_id"`
+	Committees     []Committee `json:"committees" yaml:"committees"`
+	Proposals      []Proposal  `json:"proposals" yaml:"proposals"`
+	Votes          []Vote      `json:"votes" yaml:"votes"`
+	Params         Params      `json:"params" yaml:"params"`
+}
+
+// DefaultGenesisState returns the default genesis state for the committee module, a member committee with
+// full permissions and no members. With no members its Tally passes no proposal, so committee and
+// param-change proposals can only be enacted once a genesis file lists its members.
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		NextProposalID: 0,
+		Committees: []Committee{
+			MemberCommittee{
+				BaseCommittee: BaseCommittee{
+					ID:          0,
+					Description: "Bootstrap committee",
+					Members:     []sdk.AccAddress{},
+					Permissions: []Permission{GodPermission{}},
+				},
+				VoteThreshold: sdk.MustNewDecFromStr("0.5"),
+			},
+		},
+		Params: DefaultParams(),
+	}
+}
+
+// InitGenesis initializes the committee module's state from a provided genesis state
+func InitGenesis(ctx sdk.Context, k Keeper, gs GenesisState) {
+	k.paramstore.SetParamSet(ctx, &gs.Params)
+
+	for _, committee := range gs.Committees {
+		k.SetCommittee(ctx, committee)
+	}
+	for _, proposal := range gs.Proposals {
+		k.setProposal(ctx, proposal)
+	}
+	for _, vote := range gs.Votes {
+		store := ctx.KVStore(k.key)
+		store.Set(k.voteKey(vote.ProposalID, vote.Voter), k.cdc.MustMarshalBinaryBare(vote))
+	}
+	k.setNextProposalID(ctx, gs.NextProposalID)
+}
+
+// ExportGenesis extracts the committee module's state for genesis export
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	var committees []Committee
+	k.IterateCommittees(ctx, func(c Committee) bool {
+		committees = append(committees, c)
+		return false
+	})
+
+	var proposals []Proposal
+	var votes []Vote
+	k.IterateProposals(ctx, func(p Proposal) bool {
+		proposals = append(proposals, p)
+		votes = append(votes, k.GetVotes(ctx, p.ID)...)
+		return false
+	})
+
+	return GenesisState{
+		NextProposalID: k.GetNextProposalID(ctx),
+		Committees:     committees,
+		Proposals:      proposals,
+		Votes:          votes,
+		Params:         k.GetParams(ctx),
+	}
+}
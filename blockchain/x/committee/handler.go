@@ -0,0 +1,46 @@
+package committee
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewHandler creates an sdk.Handler for the committee module's messages
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case MsgSubmitProposal:
+			return handleMsgSubmitProposal(ctx, k, msg)
+		case MsgVote:
+			return handleMsgVote(ctx, k, msg)
+		default:
+			return sdk.ErrUnknownRequest("unrecognized committee message type").Result()
+		}
+	}
+}
+
+func handleMsgSubmitProposal(ctx sdk.Context, k Keeper, msg MsgSubmitProposal) sdk.Result {
+	id, err := k.SubmitProposal(ctx, msg.Proposer, msg.CommitteeID, msg.PubProposal)
+	if err != nil {
+		return err.Result()
+	}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			"action", "submit_proposal",
+			"proposal_id", fmt.Sprintf("%d", id),
+		),
+	}
+}
+
+func handleMsgVote(ctx sdk.Context, k Keeper, msg MsgVote) sdk.Result {
+	if err := k.AddVote(ctx, msg.ProposalID, msg.Voter, msg.VoteType); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			"action", "vote",
+			"proposal_id", fmt.Sprintf("%d", msg.ProposalID),
+		),
+	}
+}
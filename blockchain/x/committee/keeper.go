@@ -0,0 +1,262 @@
+package committee
+
+import (
+	"math"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+// StakingKeeper defines the staking functionality the committee module depends on, for tallying token
+// committee votes by bonded stake
+type StakingKeeper interface {
+	TotalBondedTokens(ctx sdk.Context) sdk.Int
+	GetDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddress, maxRetrieve uint16) []staking.Delegation
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (staking.Validator, bool)
+}
+
+// Keeper stores and tallies committee proposals and votes.
+type Keeper struct {
+	key        sdk.StoreKey
+	cdc        *codec.Codec
+	paramstore params.Subspace
+	staking    StakingKeeper
+
+	proposalHandlers map[string]ProposalHandler
+}
+
+// NewKeeper creates a new committee Keeper
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramstore params.Subspace, stakingKeeper StakingKeeper) Keeper {
+	return Keeper{
+		key:              key,
+		cdc:              cdc,
+		paramstore:       paramstore.WithKeyTable(ParamKeyTable()),
+		staking:          stakingKeeper,
+		proposalHandlers: map[string]ProposalHandler{},
+	}
+}
+
+// RegisterProposalHandler registers a handler that enacts a particular PubProposal route once it passes,
+// mirroring how gov.Router lets new proposal types be added without touching the keeper.
+func (k *Keeper) RegisterProposalHandler(route string, handler ProposalHandler) {
+	k.proposalHandlers[route] = handler
+}
+
+// GetCommittee fetches a committee by ID
+func (k Keeper) GetCommittee(ctx sdk.Context, id uint64) (Committee, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(append(CommitteeKeyPrefix, GetProposalIDBytes(id)...))
+	if bz == nil {
+		return nil, false
+	}
+	var committee Committee
+	k.cdc.MustUnmarshalBinaryBare(bz, &committee)
+	return committee, true
+}
+
+// SetCommittee stores a committee
+func (k Keeper) SetCommittee(ctx sdk.Context, committee Committee) {
+	store := ctx.KVStore(k.key)
+	store.Set(append(CommitteeKeyPrefix, GetProposalIDBytes(committee.GetID())...), k.cdc.MustMarshalBinaryBare(committee))
+}
+
+// DeleteCommittee removes a committee
+func (k Keeper) DeleteCommittee(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.key)
+	store.Delete(append(CommitteeKeyPrefix, GetProposalIDBytes(id)...))
+}
+
+// GetNextProposalID returns, and does not increment, the next usable proposal ID
+func (k Keeper) GetNextProposalID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(NextProposalIDKey)
+	if bz == nil {
+		return 0
+	}
+	var id uint64
+	k.cdc.MustUnmarshalBinaryBare(bz, &id)
+	return id
+}
+
+func (k Keeper) setNextProposalID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.key)
+	store.Set(NextProposalIDKey, k.cdc.MustMarshalBinaryBare(id))
+}
+
+// GetProposal fetches a proposal by ID
+func (k Keeper) GetProposal(ctx sdk.Context, id uint64) (Proposal, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(append(ProposalKeyPrefix, GetProposalIDBytes(id)...))
+	if bz == nil {
+		return Proposal{}, false
+	}
+	var proposal Proposal
+	k.cdc.MustUnmarshalBinaryBare(bz, &proposal)
+	return proposal, true
+}
+
+func (k Keeper) setProposal(ctx sdk.Context, proposal Proposal) {
+	store := ctx.KVStore(k.key)
+	store.Set(append(ProposalKeyPrefix, GetProposalIDBytes(proposal.ID)...), k.cdc.MustMarshalBinaryBare(proposal))
+}
+
+func (k Keeper) deleteProposal(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.key)
+	store.Delete(append(ProposalKeyPrefix, GetProposalIDBytes(id)...))
+}
+
+// IterateCommittees iterates over every stored committee
+func (k Keeper) IterateCommittees(ctx sdk.Context, cb func(Committee) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iterator := sdk.KVStorePrefixIterator(store, CommitteeKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var committee Committee
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &committee)
+		if cb(committee) {
+			break
+		}
+	}
+}
+
+// IterateProposals iterates over every stored proposal
+func (k Keeper) IterateProposals(ctx sdk.Context, cb func(Proposal) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iterator := sdk.KVStorePrefixIterator(store, ProposalKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal Proposal
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &proposal)
+		if cb(proposal) {
+			break
+		}
+	}
+}
+
+// SubmitProposal validates that the committee is allowed to submit the proposal, stores it, and returns its ID
+func (k Keeper) SubmitProposal(ctx sdk.Context, proposer sdk.AccAddress, committeeID uint64, pubProposal PubProposal) (uint64, sdk.Error) {
+	committee, found := k.GetCommittee(ctx, committeeID)
+	if !found {
+		return 0, ErrUnknownCommittee(DefaultCodespace, committeeID)
+	}
+	if !committee.HasPermission(pubProposal) {
+		return 0, ErrNotAuthorized(DefaultCodespace, committeeID)
+	}
+
+	id := k.GetNextProposalID(ctx)
+	proposal := NewProposal(pubProposal, id, committeeID, ctx.BlockTime().Add(k.GetProposalDuration(ctx)))
+	k.setProposal(ctx, proposal)
+	k.setNextProposalID(ctx, id+1)
+	return id, nil
+}
+
+// AddVote records a vote by a committee member/bonded staker on an active proposal
+func (k Keeper) AddVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress, voteType VoteType) sdk.Error {
+	proposal, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return ErrUnknownProposal(DefaultCodespace, proposalID)
+	}
+	if proposal.HasExpired(ctx.BlockTime()) {
+		return ErrProposalExpired(DefaultCodespace, proposalID)
+	}
+	committee, found := k.GetCommittee(ctx, proposal.CommitteeID)
+	if !found {
+		return ErrUnknownCommittee(DefaultCodespace, proposal.CommitteeID)
+	}
+	if !committee.VoteIsAllowed(voteType) {
+		return ErrInvalidVoteType(DefaultCodespace, voteType)
+	}
+
+	store := ctx.KVStore(k.key)
+	vote := NewVote(proposalID, voter, voteType)
+	store.Set(k.voteKey(proposalID, voter), k.cdc.MustMarshalBinaryBare(vote))
+	return nil
+}
+
+func (k Keeper) voteKey(proposalID uint64, voter sdk.AccAddress) []byte {
+	return append(append(VoteKeyPrefix, GetProposalIDBytes(proposalID)...), voter.Bytes()...)
+}
+
+// GetVotes returns every vote cast so far on a proposal
+func (k Keeper) GetVotes(ctx sdk.Context, proposalID uint64) []Vote {
+	store := ctx.KVStore(k.key)
+	iterator := sdk.KVStorePrefixIterator(store, append(VoteKeyPrefix, GetProposalIDBytes(proposalID)...))
+	defer iterator.Close()
+
+	var votes []Vote
+	for ; iterator.Valid(); iterator.Next() {
+		var vote Vote
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &vote)
+		votes = append(votes, vote)
+	}
+	return votes
+}
+
+// GetProposalDuration returns the voting period new proposals are given
+func (k Keeper) GetProposalDuration(ctx sdk.Context) time.Duration {
+	var duration time.Duration
+	k.paramstore.Get(ctx, KeyProposalDuration, &duration)
+	return duration
+}
+
+// GetParams returns the committee module's parameters
+func (k Keeper) GetParams(ctx sdk.Context) Params {
+	var p Params
+	k.paramstore.GetParamSet(ctx, &p)
+	return p
+}
+
+// ProcessProposals enacts or discards every proposal whose deadline has passed, called from the EndBlocker.
+func (k Keeper) ProcessProposals(ctx sdk.Context) {
+	var expired []Proposal
+	k.IterateProposals(ctx, func(p Proposal) bool {
+		if p.HasExpired(ctx.BlockTime()) {
+			expired = append(expired, p)
+		}
+		return false
+	})
+
+	for _, proposal := range expired {
+		k.processProposal(ctx, proposal)
+	}
+}
+
+func (k Keeper) processProposal(ctx sdk.Context, proposal Proposal) {
+	committee, found := k.GetCommittee(ctx, proposal.CommitteeID)
+	if !found {
+		k.deleteProposal(ctx, proposal.ID)
+		return
+	}
+
+	votes := k.GetVotes(ctx, proposal.ID)
+	totalBonded := k.staking.TotalBondedTokens(ctx)
+	passed := committee.Tally(votes, func(addr sdk.AccAddress) sdk.Int {
+		return k.bondedTokens(ctx, addr)
+	}, totalBonded)
+
+	if passed {
+		if handler, ok := k.proposalHandlers[proposal.PubProposal.ProposalRoute()]; ok {
+			// errors are swallowed here, mirroring gov: a failed enactment does not roll back the vote
+			_ = handler(ctx, proposal.PubProposal)
+		}
+	}
+
+	k.deleteProposal(ctx, proposal.ID)
+}
+
+func (k Keeper) bondedTokens(ctx sdk.Context, addr sdk.AccAddress) sdk.Int {
+	total := sdk.ZeroDec()
+	for _, delegation := range k.staking.GetDelegatorDelegations(ctx, addr, math.MaxUint16) {
+		validator, found := k.staking.GetValidator(ctx, delegation.ValidatorAddress)
+		if !found {
+			continue
+		}
+		// shares are not 1:1 with bonded tokens once a validator has been slashed, so convert through
+		// the validator's own exchange rate rather than summing shares directly
+		total = total.Add(validator.TokensFromShares(delegation.Shares))
+	}
+	return total.TruncateInt()
+}
@@ -0,0 +1,38 @@
+package committee
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "committee"
+
+	// StoreKey is the store key string for the committee module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the committee module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the committee module
+	QuerierRoute = ModuleName
+
+	// DefaultParamspace is the default name for the param subspace used by gov proposals targeting the committee itself
+	DefaultParamspace = ModuleName
+)
+
+var (
+	// CommitteeKeyPrefix is the prefix committees are stored under
+	CommitteeKeyPrefix = []byte{0x01}
+	// ProposalKeyPrefix is the prefix proposals are stored under
+	ProposalKeyPrefix = []byte{0x02}
+	// VoteKeyPrefix is the prefix votes are stored under
+	VoteKeyPrefix = []byte{0x03}
+	// NextProposalIDKey stores the next unused proposal ID
+	NextProposalIDKey = []byte{0x04}
+)
+
+// GetProposalIDBytes returns the byte representation of a proposal ID
+func GetProposalIDBytes(id uint64) []byte {
+	b := make([]byte, 8)
+	for i := 0; i < 8; i++ {
+		b[7-i] = byte(id >> (8 * uint(i)))
+	}
+	return b
+}
@@ -0,0 +1,49 @@
+package committee
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// committee module parameter store keys
+var (
+	KeyProposalDuration = []byte("ProposalDuration")
+
+	// DefaultProposalDuration is the default voting period given to a new proposal
+	DefaultProposalDuration = 7 * 24 * time.Hour
+)
+
+// ParamKeyTable returns the param key table for the committee module
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Params are the parameters of the committee module
+type Params struct {
+	ProposalDuration time.Duration `json:"proposal_duration" yaml:"proposal_duration"`
+}
+
+// DefaultParams returns default committee module params
+func DefaultParams() Params {
+	return Params{ProposalDuration: DefaultProposalDuration}
+}
+
+// ParamSetPairs implements the ParamSet interface, linking struct fields to store keys
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyProposalDuration, &p.ProposalDuration, validateProposalDuration),
+	}
+}
+
+func validateProposalDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("proposal duration must be positive: %s", v)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package committee
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// PubProposal is the interface that any governance proposal content submitted to a committee must implement.
+// It mirrors gov.Content so the same ParameterChangeProposal types can be reused.
+type PubProposal interface {
+	GetTitle() string
+	GetDescription() string
+	ProposalRoute() string
+	ValidateBasic() error
+}
+
+// Permission restricts which PubProposals a committee is allowed to submit.
+type Permission interface {
+	Allows(proposal PubProposal) bool
+}
+
+// ParamsChangePermission allows a committee to submit params.ParameterChangeProposal changes targeting a
+// whitelisted set of subspaces, eg cdp, pricefeed and auction.
+type ParamsChangePermission struct {
+	AllowedSubspaces []string `json:"allowed_subspaces" yaml:"allowed_subspaces"`
+}
+
+// Allows implements Permission
+func (p ParamsChangePermission) Allows(proposal PubProposal) bool {
+	pcp, ok := proposal.(params.ParameterChangeProposal)
+	if !ok {
+		return false
+	}
+	for _, change := range pcp.Changes {
+		if !contains(p.AllowedSubspaces, change.Subspace) {
+			return false
+		}
+	}
+	return true
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// GodPermission allows a committee to submit any PubProposal, used for the bootstrapping member committee.
+type GodPermission struct{}
+
+// Allows implements Permission
+func (GodPermission) Allows(PubProposal) bool { return true }
+
+// CommitteeChangeProposal adds a new committee, or overwrites an existing one with the same ID.
+type CommitteeChangeProposal struct {
+	Title       string    `json:"title" yaml:"title"`
+	Description string    `json:"description" yaml:"description"`
+	Committee   Committee `json:"committee" yaml:"committee"`
+}
+
+// GetTitle implements PubProposal
+func (p CommitteeChangeProposal) GetTitle() string { return p.Title }
+
+// GetDescription implements PubProposal
+func (p CommitteeChangeProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute implements PubProposal
+func (p CommitteeChangeProposal) ProposalRoute() string { return RouterKey }
+
+// ValidateBasic implements PubProposal
+func (p CommitteeChangeProposal) ValidateBasic() error {
+	if p.Committee == nil {
+		return ErrNoCommitteeFound
+	}
+	return nil
+}
+
+// CommitteeDeleteProposal removes a committee by ID.
+type CommitteeDeleteProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	CommitteeID uint64 `json:"committee_id" yaml:"committee_id"`
+}
+
+// GetTitle implements PubProposal
+func (p CommitteeDeleteProposal) GetTitle() string { return p.Title }
+
+// GetDescription implements PubProposal
+func (p CommitteeDeleteProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute implements PubProposal
+func (p CommitteeDeleteProposal) ProposalRoute() string { return RouterKey }
+
+// ValidateBasic implements PubProposal
+func (p CommitteeDeleteProposal) ValidateBasic() error { return nil }
+
+// ProposalHandler enacts a PubProposal once its committee vote has passed, mirroring gov.Handler so new
+// proposal types can be supported without changing the keeper.
+type ProposalHandler func(ctx sdk.Context, proposal PubProposal) error
@@ -0,0 +1,115 @@
+package committee
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// committee module querier route endpoints
+const (
+	QueryCommittees = "committees"
+	QueryProposals  = "proposals"
+	QueryVotes      = "votes"
+	QueryTally      = "tally"
+)
+
+// VoteTallyResponse is returned by the tally query, giving the current yes/no/veto split of a proposal's votes
+type VoteTallyResponse struct {
+	ProposalID uint64 `json:"proposal_id" yaml:"proposal_id"`
+	Yes        int    `json:"yes" yaml:"yes"`
+	No         int    `json:"no" yaml:"no"`
+	Abstain    int    `json:"abstain" yaml:"abstain"`
+	NoWithVeto int    `json:"no_with_veto" yaml:"no_with_veto"`
+}
+
+// NewQuerier creates a querier for the committee module
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QueryCommittees:
+			return queryCommittees(ctx, k)
+		case QueryProposals:
+			return queryProposals(ctx, k)
+		case QueryVotes:
+			return queryVotes(ctx, path[1:], k)
+		case QueryTally:
+			return queryTally(ctx, path[1:], k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown committee query endpoint")
+		}
+	}
+}
+
+func queryCommittees(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	var committees []Committee
+	k.IterateCommittees(ctx, func(c Committee) bool {
+		committees = append(committees, c)
+		return false
+	})
+	bz, err := codec.MarshalJSONIndent(k.cdc, committees)
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+	return bz, nil
+}
+
+func queryProposals(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	var proposals []Proposal
+	k.IterateProposals(ctx, func(p Proposal) bool {
+		proposals = append(proposals, p)
+		return false
+	})
+	bz, err := codec.MarshalJSONIndent(k.cdc, proposals)
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+	return bz, nil
+}
+
+func queryVotes(ctx sdk.Context, args []string, k Keeper) ([]byte, sdk.Error) {
+	if len(args) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing proposal id")
+	}
+	id, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest("invalid proposal id")
+	}
+	votes := k.GetVotes(ctx, id)
+	bz, err := codec.MarshalJSONIndent(k.cdc, votes)
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+	return bz, nil
+}
+
+func queryTally(ctx sdk.Context, args []string, k Keeper) ([]byte, sdk.Error) {
+	if len(args) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing proposal id")
+	}
+	id, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest("invalid proposal id")
+	}
+	votes := k.GetVotes(ctx, id)
+	res := VoteTallyResponse{ProposalID: id}
+	for _, v := range votes {
+		switch v.VoteType {
+		case VoteTypeYes:
+			res.Yes++
+		case VoteTypeNo:
+			res.No++
+		case VoteTypeAbstain:
+			res.Abstain++
+		case VoteTypeNoWithVeto:
+			res.NoWithVeto++
+		}
+	}
+	bz, err := codec.MarshalJSONIndent(k.cdc, res)
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+	return bz, nil
+}
@@ -0,0 +1,76 @@
+package committee
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VoteType is the type of a vote cast on a proposal.
+type VoteType byte
+
+const (
+	// VoteTypeYes votes in favour of a proposal
+	VoteTypeYes VoteType = iota
+	// VoteTypeNo votes against a proposal
+	VoteTypeNo
+	// VoteTypeAbstain abstains from voting
+	VoteTypeAbstain
+	// VoteTypeNoWithVeto votes against a proposal and raises a veto, only meaningful for token committees
+	VoteTypeNoWithVeto
+)
+
+// String implements fmt.Stringer
+func (vt VoteType) String() string {
+	switch vt {
+	case VoteTypeYes:
+		return "yes"
+	case VoteTypeNo:
+		return "no"
+	case VoteTypeAbstain:
+		return "abstain"
+	case VoteTypeNoWithVeto:
+		return "no_with_veto"
+	default:
+		return "unknown"
+	}
+}
+
+// Vote is a vote on a proposal, cast by a member or bonded staker.
+type Vote struct {
+	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"`
+	Voter      sdk.AccAddress `json:"voter" yaml:"voter"`
+	VoteType   VoteType       `json:"vote_type" yaml:"vote_type"`
+}
+
+// NewVote instantiates a new Vote
+func NewVote(proposalID uint64, voter sdk.AccAddress, voteType VoteType) Vote {
+	return Vote{
+		ProposalID: proposalID,
+		Voter:      voter,
+		VoteType:   voteType,
+	}
+}
+
+// Proposal is a PubProposal with the metadata tracked by the committee module to enact or expire it.
+type Proposal struct {
+	PubProposal PubProposal `json:"pub_proposal" yaml:"pub_proposal"`
+	ID          uint64      `json:"id" yaml:"id"`
+	CommitteeID uint64      `json:"committee_id" yaml:"committee_id"`
+	Deadline    time.Time   `json:"deadline" yaml:"deadline"`
+}
+
+// NewProposal instantiates a new Proposal
+func NewProposal(pubProposal PubProposal, id, committeeID uint64, deadline time.Time) Proposal {
+	return Proposal{
+		PubProposal: pubProposal,
+		ID:          id,
+		CommitteeID: committeeID,
+		Deadline:    deadline,
+	}
+}
+
+// HasExpired returns whether the proposal's voting period has ended
+func (p Proposal) HasExpired(blockTime time.Time) bool {
+	return !blockTime.Before(p.Deadline)
+}
@@ -0,0 +1,11 @@
+package incentive
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker mints and accumulates this block's USDX rewards into every active reward period's
+// cumulative factor
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	k.AccumulateRewards(ctx)
+}
@@ -0,0 +1,18 @@
+package incentive
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+var moduleCdc = codec.New()
+
+// RegisterCodec registers the incentive module's concrete types for amino encoding
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgClaimReward{}, "incentive/MsgClaimReward", nil)
+	cdc.RegisterConcrete(Claim{}, "incentive/Claim", nil)
+}
+
+func init() {
+	RegisterCodec(moduleCdc)
+	moduleCdc.Seal()
+}
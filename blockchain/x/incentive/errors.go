@@ -0,0 +1,30 @@
+package incentive
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultCodespace is the incentive module's unique error codespace
+const DefaultCodespace sdk.CodespaceType = ModuleName
+
+// incentive module error codes
+const (
+	CodeClaimNotFound      sdk.CodeType = 1
+	CodeMultiplierNotFound sdk.CodeType = 2
+	CodeIncentivesInactive sdk.CodeType = 3
+)
+
+// ErrClaimNotFound returns an error when an owner has no claim for a collateral type
+func ErrClaimNotFound(codespace sdk.CodespaceType, owner sdk.AccAddress, collateralType string) sdk.Error {
+	return sdk.NewError(codespace, CodeClaimNotFound, "no claim for %s found for %s", collateralType, owner)
+}
+
+// ErrMultiplierNotFound returns an error when a named multiplier isn't offered by the collateral type's claim period
+func ErrMultiplierNotFound(codespace sdk.CodespaceType, collateralType string, name string) sdk.Error {
+	return sdk.NewError(codespace, CodeMultiplierNotFound, "multiplier %s not offered for %s", name, collateralType)
+}
+
+// ErrIncentivesInactive returns an error when a claim is attempted while the module is deactivated
+func ErrIncentivesInactive(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeIncentivesInactive, "incentive module is not active")
+}
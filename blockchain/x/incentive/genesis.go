@@ -0,0 +1,62 @@
+package incentive
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisRewardFactor pairs a collateral type with its cumulative reward factor, for genesis export
+type GenesisRewardFactor struct {
+	CollateralType string  `json:"collateral_type" yaml:"collateral_type"`
+	Factor         sdk.Dec `json:"factor" yaml:"factor"`
+}
+
+// GenesisState is the state that must be provided at genesis for the incentive module
+type GenesisState struct {
+	Params        Params                `json:"params" yaml:"params"`
+	RewardFactors []GenesisRewardFactor `json:"reward_factors" yaml:"reward_factors"`
+	Claims        []Claim               `json:"claims" yaml:"claims"`
+}
+
+// DefaultGenesisState returns the default genesis state for the incentive module, inactive with no
+// reward periods, claims or accumulated factors
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params:        DefaultParams(),
+		RewardFactors: []GenesisRewardFactor{},
+		Claims:        []Claim{},
+	}
+}
+
+// InitGenesis initializes the incentive module's state from a provided genesis state
+func InitGenesis(ctx sdk.Context, k Keeper, gs GenesisState) {
+	k.SetParams(ctx, gs.Params)
+	for _, rf := range gs.RewardFactors {
+		k.SetRewardFactor(ctx, rf.CollateralType, rf.Factor)
+	}
+	for _, claim := range gs.Claims {
+		k.SetClaim(ctx, claim)
+	}
+}
+
+// ExportGenesis extracts the incentive module's state for genesis export
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	var claims []Claim
+	k.IterateClaims(ctx, func(claim Claim) bool {
+		claims = append(claims, claim)
+		return false
+	})
+
+	var factors []GenesisRewardFactor
+	for _, rp := range k.GetParams(ctx).RewardPeriods {
+		factors = append(factors, GenesisRewardFactor{
+			CollateralType: rp.CollateralType,
+			Factor:         k.GetRewardFactor(ctx, rp.CollateralType),
+		})
+	}
+
+	return GenesisState{
+		Params:        k.GetParams(ctx),
+		RewardFactors: factors,
+		Claims:        claims,
+	}
+}
@@ -0,0 +1,30 @@
+package incentive
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewHandler creates an sdk.Handler for the incentive module's messages
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case MsgClaimReward:
+			return handleMsgClaimReward(ctx, k, msg)
+		default:
+			return sdk.ErrUnknownRequest("unrecognized incentive message type").Result()
+		}
+	}
+}
+
+func handleMsgClaimReward(ctx sdk.Context, k Keeper, msg MsgClaimReward) sdk.Result {
+	if err := k.ClaimReward(ctx, msg.Owner, msg.CollateralType, msg.MultiplierName); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			"action", "claim_reward",
+			"owner", msg.Owner.String(),
+			"collateral_type", msg.CollateralType,
+		),
+	}
+}
@@ -0,0 +1,34 @@
+package incentive
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CdpHooks is the set of hooks the incentive module needs cdp.Keeper to call on every CDP operation
+// that can change a CDP's principal, so that a reward change is synchronized immediately rather than
+// waiting for the next block's AccumulateRewards sweep (see keeper.go).
+//
+// NOTE: wiring this up requires cdp.Keeper to grow a CdpHooks field and call
+// AfterCdpPrincipalChanged from its Deposit/Withdraw/DrawDebt/RepayDebt handlers, mirroring how
+// staking.Keeper calls out to its own StakingHooks (see staking.NewMultiStakingHooks in app.go). That change
+// belongs in the cdp module itself; until it lands, Hooks below is unused by cdp. This is purely an
+// optimization: AccumulateRewards already synchronizes every CDP owner of a collateral type each time
+// its reward factor advances, so no minted reward goes unclaimable without it.
+type CdpHooks interface {
+	AfterCdpPrincipalChanged(ctx sdk.Context, owner sdk.AccAddress, collateralType string, principal sdk.Int)
+}
+
+// Hooks implements CdpHooks for the incentive module's Keeper
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns a wrapper struct implementing CdpHooks, for registering with cdp.Keeper
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterCdpPrincipalChanged implements CdpHooks
+func (h Hooks) AfterCdpPrincipalChanged(ctx sdk.Context, owner sdk.AccAddress, collateralType string, principal sdk.Int) {
+	h.k.SynchronizeReward(ctx, owner, collateralType, principal)
+}
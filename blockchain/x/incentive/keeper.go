@@ -0,0 +1,335 @@
+package incentive
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// CdpKeeper defines the cdp functionality the incentive module depends on, decoupled from the
+// concrete cdp.Keeper so the two modules don't need to import one another's internals
+type CdpKeeper interface {
+	GetTotalPrincipal(ctx sdk.Context, collateralType string) sdk.Int
+	IterateCdpsByCollateralType(ctx sdk.Context, collateralType string, cb func(owner sdk.AccAddress, principal sdk.Int) (stop bool))
+}
+
+// SupplyKeeper defines the supply functionality the incentive module depends on, to mint reward
+// coins and move them into and out of its module account
+type SupplyKeeper interface {
+	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) sdk.Error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+}
+
+// AccountKeeper defines the auth functionality the incentive module depends on, to fund a vested
+// claim payout into a PeriodicVestingAccount
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) auth.Account
+	SetAccount(ctx sdk.Context, acc auth.Account)
+	NewAccountWithAddress(ctx sdk.Context, addr sdk.AccAddress) auth.Account
+}
+
+// Keeper accumulates and pays out USDX rewards to CDP owners for maintaining collateral
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        *codec.Codec
+	paramstore params.Subspace
+	cdpKeeper  CdpKeeper
+	supply     SupplyKeeper
+	account    AccountKeeper
+}
+
+// NewKeeper creates a new incentive Keeper
+func NewKeeper(
+	cdc *codec.Codec, key sdk.StoreKey, paramstore params.Subspace,
+	cdpKeeper CdpKeeper, supplyKeeper SupplyKeeper, accountKeeper AccountKeeper,
+) Keeper {
+	return Keeper{
+		storeKey:   key,
+		cdc:        cdc,
+		paramstore: paramstore.WithKeyTable(ParamKeyTable()),
+		cdpKeeper:  cdpKeeper,
+		supply:     supplyKeeper,
+		account:    accountKeeper,
+	}
+}
+
+// GetParams returns the incentive module's parameters
+func (k Keeper) GetParams(ctx sdk.Context) Params {
+	var p Params
+	k.paramstore.GetParamSet(ctx, &p)
+	return p
+}
+
+// SetParams sets the incentive module's parameters
+func (k Keeper) SetParams(ctx sdk.Context, p Params) {
+	k.paramstore.SetParamSet(ctx, &p)
+}
+
+// GetRewardFactor returns the cumulative reward factor for a collateral type, or zero if none has
+// accumulated yet
+func (k Keeper) GetRewardFactor(ctx sdk.Context, collateralType string) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetRewardFactorKey(collateralType))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+	var factor sdk.Dec
+	k.cdc.MustUnmarshalBinaryBare(bz, &factor)
+	return factor
+}
+
+// SetRewardFactor sets the cumulative reward factor for a collateral type
+func (k Keeper) SetRewardFactor(ctx sdk.Context, collateralType string, factor sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetRewardFactorKey(collateralType), k.cdc.MustMarshalBinaryBare(factor))
+}
+
+// GetClaim returns owner's outstanding claim against collateralType, if any
+func (k Keeper) GetClaim(ctx sdk.Context, owner sdk.AccAddress, collateralType string) (Claim, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetClaimKey(owner, collateralType))
+	if bz == nil {
+		return Claim{}, false
+	}
+	var claim Claim
+	k.cdc.MustUnmarshalBinaryBare(bz, &claim)
+	return claim, true
+}
+
+// SetClaim stores a claim
+func (k Keeper) SetClaim(ctx sdk.Context, claim Claim) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetClaimKey(claim.Owner, claim.CollateralType), k.cdc.MustMarshalBinaryBare(claim))
+}
+
+// DeleteClaim removes an owner's claim against a collateral type
+func (k Keeper) DeleteClaim(ctx sdk.Context, owner sdk.AccAddress, collateralType string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetClaimKey(owner, collateralType))
+}
+
+// IterateClaims iterates over every stored claim
+func (k Keeper) IterateClaims(ctx sdk.Context, cb func(Claim) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ClaimKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var claim Claim
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &claim)
+		if cb(claim) {
+			break
+		}
+	}
+}
+
+// GetPreviousAccrualTime returns the last time a collateral type's reward factor was updated, or the
+// current block time if it has never been accumulated before
+func (k Keeper) GetPreviousAccrualTime(ctx sdk.Context, collateralType string) time.Time {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetPreviousAccrualTimeKey(collateralType))
+	if bz == nil {
+		return ctx.BlockTime()
+	}
+	var t time.Time
+	k.cdc.MustUnmarshalBinaryBare(bz, &t)
+	return t
+}
+
+// SetPreviousAccrualTime sets the last time a collateral type's reward factor was updated
+func (k Keeper) SetPreviousAccrualTime(ctx sdk.Context, collateralType string, t time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetPreviousAccrualTimeKey(collateralType), k.cdc.MustMarshalBinaryBare(t))
+}
+
+// AccumulateRewards advances every active reward period's cumulative factor by the amount of reward
+// emitted per unit of principal since that collateral type was last accumulated, minting the newly
+// emitted coins into the incentive module account and synchronizing every CDP owner of that collateral
+// type so the newly minted reward is immediately reflected in a claimable Claim
+func (k Keeper) AccumulateRewards(ctx sdk.Context) {
+	if !k.GetParams(ctx).Active {
+		return
+	}
+
+	for _, rp := range k.GetParams(ctx).RewardPeriods {
+		blockTime := ctx.BlockTime()
+		if blockTime.Before(rp.Start) || !blockTime.Before(rp.End) {
+			continue
+		}
+
+		previousAccrual := k.GetPreviousAccrualTime(ctx, rp.CollateralType)
+		if previousAccrual.Before(rp.Start) {
+			previousAccrual = rp.Start
+		}
+		elapsed := blockTime.Sub(previousAccrual).Seconds()
+		k.SetPreviousAccrualTime(ctx, rp.CollateralType, blockTime)
+		if elapsed <= 0 {
+			continue
+		}
+
+		totalPrincipal := k.cdpKeeper.GetTotalPrincipal(ctx, rp.CollateralType)
+		if !totalPrincipal.IsPositive() {
+			continue
+		}
+
+		periodSeconds := rp.End.Sub(rp.Start).Seconds()
+		if periodSeconds <= 0 {
+			continue
+		}
+		rewardPerSecond := sdk.NewDecFromInt(rp.Reward.Amount).QuoInt64(int64(periodSeconds))
+		newReward := rewardPerSecond.MulInt64(int64(elapsed))
+
+		if err := k.supply.MintCoins(ctx, ModuleAccountName, sdk.NewCoins(sdk.NewCoin(rp.Reward.Denom, newReward.TruncateInt()))); err != nil {
+			continue
+		}
+
+		factorIncrease := newReward.QuoInt(totalPrincipal)
+		currentFactor := k.GetRewardFactor(ctx, rp.CollateralType)
+		// owners seen for the first time are recorded at the factor from before this increase, so the
+		// sync below credits them their share of this mint as well
+		k.cdpKeeper.IterateCdpsByCollateralType(ctx, rp.CollateralType, func(owner sdk.AccAddress, _ sdk.Int) bool {
+			if _, found := k.GetOwnerFactor(ctx, owner, rp.CollateralType); !found {
+				k.SetOwnerFactor(ctx, owner, rp.CollateralType, currentFactor)
+			}
+			return false
+		})
+		k.SetRewardFactor(ctx, rp.CollateralType, currentFactor.Add(factorIncrease))
+
+		// cdp.Keeper does not yet call into incentive on every principal change (see hooks.go), so
+		// every owner of this collateral type is synchronized here instead, crediting their share of
+		// what was just minted into a claimable Claim rather than leaving it stranded in the module
+		// account
+		k.cdpKeeper.IterateCdpsByCollateralType(ctx, rp.CollateralType, func(owner sdk.AccAddress, principal sdk.Int) bool {
+			k.SynchronizeReward(ctx, owner, rp.CollateralType, principal)
+			return false
+		})
+	}
+}
+
+// GetOwnerFactor returns owner's last-synced reward factor for collateralType
+func (k Keeper) GetOwnerFactor(ctx sdk.Context, owner sdk.AccAddress, collateralType string) (sdk.Dec, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetOwnerFactorKey(owner, collateralType))
+	if bz == nil {
+		return sdk.ZeroDec(), false
+	}
+	var factor sdk.Dec
+	k.cdc.MustUnmarshalBinaryBare(bz, &factor)
+	return factor, true
+}
+
+// SetOwnerFactor sets owner's last-synced reward factor for collateralType
+func (k Keeper) SetOwnerFactor(ctx sdk.Context, owner sdk.AccAddress, collateralType string, factor sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetOwnerFactorKey(owner, collateralType), k.cdc.MustMarshalBinaryBare(factor))
+}
+
+// SynchronizeReward credits owner with the reward accumulated by principal (their CDP's outstanding
+// principal for collateralType) since their last sync, then advances their last-synced factor to the
+// current one. It is called from AccumulateRewards for every CDP owner each time a collateral type's
+// reward factor advances, and can additionally be called from a CdpHooks callback (see hooks.go) to
+// synchronize a single owner immediately on a principal change.
+func (k Keeper) SynchronizeReward(ctx sdk.Context, owner sdk.AccAddress, collateralType string, principal sdk.Int) {
+	currentFactor := k.GetRewardFactor(ctx, collateralType)
+	lastFactor, found := k.GetOwnerFactor(ctx, owner, collateralType)
+	if !found {
+		// first time this owner is synced for this collateral type; nothing has accrued for them yet
+		k.SetOwnerFactor(ctx, owner, collateralType, currentFactor)
+		return
+	}
+
+	accrued := sdk.NewDecFromInt(principal).Mul(currentFactor.Sub(lastFactor))
+	if accrued.IsPositive() {
+		claim, found := k.GetClaim(ctx, owner, collateralType)
+		if !found {
+			claim = Claim{Owner: owner, CollateralType: collateralType, Reward: sdk.NewCoin(k.rewardDenom(ctx, collateralType), sdk.ZeroInt())}
+		}
+		claim.Reward = claim.Reward.Add(sdk.NewCoin(claim.Reward.Denom, accrued.TruncateInt()))
+		k.SetClaim(ctx, claim)
+	}
+	k.SetOwnerFactor(ctx, owner, collateralType, currentFactor)
+}
+
+func (k Keeper) rewardDenom(ctx sdk.Context, collateralType string) string {
+	for _, rp := range k.GetParams(ctx).RewardPeriods {
+		if rp.CollateralType == collateralType {
+			return rp.Reward.Denom
+		}
+	}
+	return "usdx"
+}
+
+// ClaimReward pays out owner's accumulated reward for collateralType, applying the vesting terms of
+// the named multiplier from that collateral type's active claim period
+func (k Keeper) ClaimReward(ctx sdk.Context, owner sdk.AccAddress, collateralType, multiplierName string) sdk.Error {
+	if !k.GetParams(ctx).Active {
+		return ErrIncentivesInactive(DefaultCodespace)
+	}
+
+	claim, found := k.GetClaim(ctx, owner, collateralType)
+	if !found || !claim.Reward.IsPositive() {
+		return ErrClaimNotFound(DefaultCodespace, owner, collateralType)
+	}
+
+	multiplier, err := k.getClaimMultiplier(ctx, collateralType, multiplierName)
+	if err != nil {
+		return err
+	}
+
+	payout := sdk.NewCoin(claim.Reward.Denom, sdk.NewDecFromInt(claim.Reward.Amount).Mul(multiplier.Factor).TruncateInt())
+	k.DeleteClaim(ctx, owner, collateralType)
+
+	if multiplier.LockupMonths <= 0 {
+		return k.supply.SendCoinsFromModuleToAccount(ctx, ModuleAccountName, owner, sdk.NewCoins(payout))
+	}
+	return k.payoutVested(ctx, owner, sdk.NewCoins(payout), multiplier.LockupMonths)
+}
+
+func (k Keeper) getClaimMultiplier(ctx sdk.Context, collateralType, multiplierName string) (ClaimMultiplier, sdk.Error) {
+	for _, cp := range k.GetParams(ctx).ClaimPeriods {
+		if cp.CollateralType != collateralType || !ctx.BlockTime().Before(cp.End) {
+			continue
+		}
+		if m, found := cp.GetClaimMultiplier(multiplierName); found {
+			return m, nil
+		}
+	}
+	return ClaimMultiplier{}, ErrMultiplierNotFound(DefaultCodespace, collateralType, multiplierName)
+}
+
+// payoutVested sends amt to owner wrapped in vesting periods spread monthly over lockupMonths, so the
+// whole payout unlocks linearly instead of all at once
+func (k Keeper) payoutVested(ctx sdk.Context, owner sdk.AccAddress, amt sdk.Coins, lockupMonths int64) sdk.Error {
+	const secondsPerMonth = int64(30 * 24 * 60 * 60)
+
+	acc := k.account.GetAccount(ctx, owner)
+	if acc == nil {
+		acc = k.account.NewAccountWithAddress(ctx, owner)
+	}
+	baseAccount, ok := acc.(*auth.BaseAccount)
+	if !ok {
+		// owner already has a specialized account (eg an existing vesting account); fall back to an
+		// instant payout rather than risk clobbering it
+		return k.supply.SendCoinsFromModuleToAccount(ctx, ModuleAccountName, owner, amt)
+	}
+
+	periods := make(auth.Periods, lockupMonths)
+	remaining := amt
+	for i := int64(0); i < lockupMonths-1; i++ {
+		shares := make([]sdk.Coin, 0, len(amt))
+		for _, c := range amt {
+			shares = append(shares, sdk.NewCoin(c.Denom, c.Amount.Quo(sdk.NewInt(lockupMonths))))
+		}
+		share := sdk.NewCoins(shares...)
+		remaining = remaining.Sub(share)
+		periods[i] = auth.Period{Length: secondsPerMonth, Amount: share}
+	}
+	periods[lockupMonths-1] = auth.Period{Length: secondsPerMonth, Amount: remaining}
+
+	vestingAcc := auth.NewPeriodicVestingAccount(baseAccount, ctx.BlockTime().Unix(), periods)
+	k.account.SetAccount(ctx, vestingAcc)
+
+	return k.supply.SendCoinsFromModuleToAccount(ctx, ModuleAccountName, owner, amt)
+}
@@ -0,0 +1,58 @@
+package incentive
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "incentive"
+
+	// StoreKey is the store key string for the incentive module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the incentive module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the incentive module
+	QuerierRoute = ModuleName
+
+	// DefaultParamspace is the default name for the incentive param subspace
+	DefaultParamspace = ModuleName
+
+	// ModuleAccountName is the name of the module account that holds undistributed rewards
+	ModuleAccountName = ModuleName
+)
+
+var (
+	// RewardFactorKeyPrefix is the prefix reward factors are stored under, keyed by collateral type
+	RewardFactorKeyPrefix = []byte{0x01}
+	// ClaimKeyPrefix is the prefix claims are stored under, keyed by (owner, collateral type)
+	ClaimKeyPrefix = []byte{0x02}
+	// PreviousAccrualTimeKeyPrefix is the prefix the last time rewards were accumulated is stored
+	// under, keyed by collateral type
+	PreviousAccrualTimeKeyPrefix = []byte{0x03}
+	// OwnerFactorKeyPrefix is the prefix an owner's last-synced reward factor is stored under,
+	// keyed by (owner, collateral type)
+	OwnerFactorKeyPrefix = []byte{0x04}
+)
+
+// GetRewardFactorKey builds the store key for a collateral type's reward factor
+func GetRewardFactorKey(collateralType string) []byte {
+	return append(RewardFactorKeyPrefix, []byte(collateralType)...)
+}
+
+// GetClaimKey builds the store key for an owner's claim on a given collateral type
+func GetClaimKey(owner sdk.AccAddress, collateralType string) []byte {
+	return append(append(ClaimKeyPrefix, owner.Bytes()...), []byte(collateralType)...)
+}
+
+// GetPreviousAccrualTimeKey builds the store key for a collateral type's last reward accrual time
+func GetPreviousAccrualTimeKey(collateralType string) []byte {
+	return append(PreviousAccrualTimeKeyPrefix, []byte(collateralType)...)
+}
+
+// GetOwnerFactorKey builds the store key for an owner's last-synced reward factor for a collateral type
+func GetOwnerFactorKey(owner sdk.AccAddress, collateralType string) []byte {
+	return append(append(OwnerFactorKeyPrefix, owner.Bytes()...), []byte(collateralType)...)
+}
@@ -0,0 +1,80 @@
+package incentive
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// AppModuleBasic defines the basic application module used by the incentive module.
+type AppModuleBasic struct{}
+
+// Name implements module.AppModuleBasic
+func (AppModuleBasic) Name() string { return ModuleName }
+
+// RegisterCodec implements module.AppModuleBasic
+func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) { RegisterCodec(cdc) }
+
+// DefaultGenesis implements module.AppModuleBasic
+func (AppModuleBasic) DefaultGenesis() json.RawMessage {
+	return moduleCdc.MustMarshalJSON(DefaultGenesisState())
+}
+
+// ValidateGenesis implements module.AppModuleBasic
+func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
+	var gs GenesisState
+	return moduleCdc.UnmarshalJSON(bz, &gs)
+}
+
+// AppModule implements module.AppModule for the incentive module.
+type AppModule struct {
+	AppModuleBasic
+	keeper Keeper
+}
+
+// NewAppModule creates a new AppModule for the incentive module
+func NewAppModule(keeper Keeper) AppModule {
+	return AppModule{keeper: keeper}
+}
+
+// RegisterInvariants implements module.AppModule
+func (AppModule) RegisterInvariants(sdk.InvariantRegistry) {}
+
+// Route implements module.AppModule
+func (AppModule) Route() string { return RouterKey }
+
+// NewHandler implements module.AppModule
+func (am AppModule) NewHandler() sdk.Handler { return NewHandler(am.keeper) }
+
+// QuerierRoute implements module.AppModule
+func (AppModule) QuerierRoute() string { return QuerierRoute }
+
+// NewQuerierHandler implements module.AppModule
+func (am AppModule) NewQuerierHandler() sdk.Querier { return NewQuerier(am.keeper) }
+
+// InitGenesis implements module.AppModule
+func (am AppModule) InitGenesis(ctx sdk.Context, bz json.RawMessage) []abci.ValidatorUpdate {
+	var gs GenesisState
+	moduleCdc.MustUnmarshalJSON(bz, &gs)
+	InitGenesis(ctx, am.keeper, gs)
+	return nil
+}
+
+// ExportGenesis implements module.AppModule
+func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	return moduleCdc.MustMarshalJSON(ExportGenesis(ctx, am.keeper))
+}
+
+// BeginBlock implements module.AppModule
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	BeginBlocker(ctx, am.keeper)
+}
+
+// EndBlock implements module.AppModule
+func (AppModule) EndBlock(sdk.Context, abci.RequestEndBlock) []abci.ValidatorUpdate { return nil }
+
+var _ module.AppModule = AppModule{}
+var _ module.AppModuleBasic = AppModuleBasic{}
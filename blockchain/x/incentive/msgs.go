@@ -0,0 +1,43 @@
+package incentive
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgClaimReward transfers Owner's accumulated reward for CollateralType out of the incentive module
+// account, applying the vesting terms of the named MultiplierName
+type MsgClaimReward struct {
+	Owner          sdk.AccAddress `json:"owner" yaml:"owner"`
+	CollateralType string         `json:"collateral_type" yaml:"collateral_type"`
+	MultiplierName string         `json:"multiplier_name" yaml:"multiplier_name"`
+}
+
+// Route implements sdk.Msg
+func (msg MsgClaimReward) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgClaimReward) Type() string { return "claim_reward" }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgClaimReward) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress("owner address cannot be empty")
+	}
+	if msg.CollateralType == "" {
+		return sdk.ErrUnknownRequest("collateral type cannot be empty")
+	}
+	if msg.MultiplierName == "" {
+		return sdk.ErrUnknownRequest("multiplier name cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgClaimReward) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgClaimReward) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
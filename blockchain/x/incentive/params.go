@@ -0,0 +1,96 @@
+package incentive
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// incentive module parameter store keys
+var (
+	KeyActive        = []byte("Active")
+	KeyRewardPeriods = []byte("RewardPeriods")
+	KeyClaimPeriods  = []byte("ClaimPeriods")
+	KeyClaimEnd      = []byte("ClaimEnd") // not registered in Params.ParamSetPairs; no claim deadline is enforced
+)
+
+// ParamKeyTable returns the param key table for the incentive module
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Params governs the reward periods and claim periods on offer for the incentive module
+type Params struct {
+	Active        bool           `json:"active" yaml:"active"`
+	RewardPeriods []RewardPeriod `json:"reward_periods" yaml:"reward_periods"`
+	ClaimPeriods  []ClaimPeriod  `json:"claim_periods" yaml:"claim_periods"`
+}
+
+// NewParams instantiates a new Params
+func NewParams(active bool, rewardPeriods []RewardPeriod, claimPeriods []ClaimPeriod) Params {
+	return Params{
+		Active:        active,
+		RewardPeriods: rewardPeriods,
+		ClaimPeriods:  claimPeriods,
+	}
+}
+
+// DefaultParams returns default incentive params, inactive with no reward or claim periods configured
+func DefaultParams() Params {
+	return NewParams(false, []RewardPeriod{}, []ClaimPeriod{})
+}
+
+// ParamSetPairs implements the ParamSet interface
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyActive, &p.Active, validateActive),
+		params.NewParamSetPair(KeyRewardPeriods, &p.RewardPeriods, validateRewardPeriods),
+		params.NewParamSetPair(KeyClaimPeriods, &p.ClaimPeriods, validateClaimPeriods),
+	}
+}
+
+func validateActive(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateRewardPeriods(i interface{}) error {
+	rewardPeriods, ok := i.([]RewardPeriod)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	for _, rp := range rewardPeriods {
+		if rp.CollateralType == "" {
+			return fmt.Errorf("reward period collateral type cannot be empty")
+		}
+		if !rp.End.After(rp.Start) {
+			return fmt.Errorf("reward period for %s: end must be after start", rp.CollateralType)
+		}
+		if !rp.Reward.IsValid() {
+			return fmt.Errorf("reward period for %s: invalid reward coin", rp.CollateralType)
+		}
+	}
+	return nil
+}
+
+func validateClaimPeriods(i interface{}) error {
+	claimPeriods, ok := i.([]ClaimPeriod)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	for _, cp := range claimPeriods {
+		if cp.CollateralType == "" {
+			return fmt.Errorf("claim period collateral type cannot be empty")
+		}
+		for _, m := range cp.ClaimMultipliers {
+			if m.Factor.IsNegative() || m.Factor.GT(sdk.OneDec()) {
+				return fmt.Errorf("claim period %s: multiplier %s factor must be between 0 and 1", cp.CollateralType, m.Name)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package incentive
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// incentive module querier route endpoints
+const (
+	QueryClaims        = "claims"
+	QueryRewardPeriods = "reward-periods"
+)
+
+// NewQuerier creates a querier for the incentive module
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QueryClaims:
+			return queryClaims(ctx, path[1:], k)
+		case QueryRewardPeriods:
+			return queryRewardPeriods(ctx, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown incentive query endpoint")
+		}
+	}
+}
+
+// queryClaims returns outstanding claims, optionally filtered by owner, eg "claims/<addr>"
+func queryClaims(ctx sdk.Context, args []string, k Keeper) ([]byte, sdk.Error) {
+	var ownerFilter sdk.AccAddress
+	if len(args) > 0 {
+		addr, err := sdk.AccAddressFromBech32(args[0])
+		if err != nil {
+			return nil, sdk.ErrInvalidAddress(args[0])
+		}
+		ownerFilter = addr
+	}
+
+	var claims []Claim
+	k.IterateClaims(ctx, func(claim Claim) bool {
+		if !ownerFilter.Empty() && !claim.Owner.Equals(ownerFilter) {
+			return false
+		}
+		claims = append(claims, claim)
+		return false
+	})
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, claims)
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+	return bz, nil
+}
+
+// queryRewardPeriods returns every configured reward period, including those that have already expired
+func queryRewardPeriods(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(k.cdc, k.GetParams(ctx).RewardPeriods)
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+	return bz, nil
+}
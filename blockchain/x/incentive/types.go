@@ -0,0 +1,52 @@
+package incentive
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RewardPeriod defines a period during which newly-minted Reward coins are emitted to CDP owners of
+// CollateralType, proportionally to how much of the collateral type's total principal they owe
+type RewardPeriod struct {
+	CollateralType string    `json:"collateral_type" yaml:"collateral_type"`
+	Start          time.Time `json:"start" yaml:"start"`
+	End            time.Time `json:"end" yaml:"end"`
+	Reward         sdk.Coin  `json:"reward" yaml:"reward"` // total reward emitted over the period's duration
+}
+
+// ClaimMultiplier defines how much of a claim is paid out for a given vesting lockup, eg claiming
+// with the "small" multiplier pays out LockupMonths over 0 months (instantly) but at a discount,
+// while "large" pays out the full reward but vested linearly over LockupMonths
+type ClaimMultiplier struct {
+	Name         string  `json:"name" yaml:"name"`
+	Factor       sdk.Dec `json:"factor" yaml:"factor"`
+	LockupMonths int64   `json:"lockup_months" yaml:"lockup_months"`
+}
+
+// ClaimPeriod defines the ClaimMultipliers on offer for claims against CollateralType's rewards made
+// before End. Several claim periods, told apart by ID, may be configured for the same collateral type;
+// a claim uses the first one that has not ended and offers the named multiplier
+type ClaimPeriod struct {
+	CollateralType   string            `json:"collateral_type" yaml:"collateral_type"`
+	ID               uint64            `json:"id" yaml:"id"`
+	End              time.Time         `json:"end" yaml:"end"`
+	ClaimMultipliers []ClaimMultiplier `json:"claim_multipliers" yaml:"claim_multipliers"`
+}
+
+// Claim is the amount of Reward a cdp Owner has accumulated for CollateralType but not yet claimed
+type Claim struct {
+	Owner          sdk.AccAddress `json:"owner" yaml:"owner"`
+	CollateralType string         `json:"collateral_type" yaml:"collateral_type"`
+	Reward         sdk.Coin       `json:"reward" yaml:"reward"`
+}
+
+// GetClaimMultiplier looks up a named multiplier among a claim period's offered multipliers
+func (cp ClaimPeriod) GetClaimMultiplier(name string) (ClaimMultiplier, bool) {
+	for _, m := range cp.ClaimMultipliers {
+		if m.Name == name {
+			return m, true
+		}
+	}
+	return ClaimMultiplier{}, false
+}